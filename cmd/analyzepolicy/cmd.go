@@ -0,0 +1,154 @@
+package analyzepolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/osd-network-verifier/cmd/utils"
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/openshift/osd-network-verifier/pkg/policy"
+)
+
+type analyzePolicyConfig struct {
+	platformType        string
+	region              string
+	policyFormat        string
+	outputFormat        string
+	egressListLocation  string
+	egressListOverlays  []string
+	egressListVariables map[string]string
+}
+
+func NewCmdAnalyzePolicy() *cobra.Command {
+	config := analyzePolicyConfig{}
+
+	analyzePolicyCmd := &cobra.Command{
+		Use:   "analyze-policy <policy-file>",
+		Short: "Check a firewall or proxy allowlist against the egress list, without touching the cloud",
+		Long: `Statically evaluate a customer's firewall or proxy allowlist against the egress list the egress
+subcommand would test for a platform and region, reporting which endpoints the policy would block.
+Nothing is launched and the network isn't used. Supported policy formats (--format):
+
+  squid                 squid.conf, evaluated through its "acl" and "http_access" directives.
+                        Client (src) ACLs are assumed to match the cluster, unless they only
+                        hold loopback addresses (e.g., squid's builtin "localhost" ACL)
+  domains               one domain (".example.com" or "*.example.com" for subdomains), IP address
+                        or CIDR per line, optionally followed by ":port"
+  aws-network-firewall  AWS Network Firewall stateful domain list rule group (JSON), e.g., as
+                        produced by "egress-list export --format aws-network-firewall"
+
+If --format isn't set, it's inferred from the file name: .conf files are squid configs, .json files
+are AWS Network Firewall rule groups and all other files are domain allowlists. Endpoints whose
+verdict depends on facts the analysis can't know (e.g., what a hostname resolves to) are reported
+as unknown. Exits non-zero if the policy would block any required endpoint.`,
+		Example: `./osd-network-verifier analyze-policy --platform aws-classic --region us-east-1 squid.conf
+./osd-network-verifier analyze-policy --format domains allowlist.txt`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			if err := utils.ValidateOutputFormat(config.outputFormat); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			platformType, err := cloud.ByName(config.platformType)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if config.policyFormat == "" {
+				config.policyFormat = policy.DetectFormat(args[0])
+			}
+			if !slices.Contains(policy.Formats, config.policyFormat) {
+				fmt.Printf("unknown policy format '%s', must be one of %s\n", config.policyFormat, strings.Join(policy.Formats, ", "))
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if err := egress_lists.ValidateVariables(config.egressListVariables); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if config.region == "" {
+				config.region = utils.GetDefaultRegion(platformType)
+			}
+
+			policyData, err := os.ReadFile(args[0])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			p, err := policy.Parse(config.policyFormat, policyData, os.ReadFile)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", args[0], err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			egressList, err := utils.ResolveLocalEgressList(platformType, config.region, config.egressListLocation, config.egressListOverlays, config.egressListVariables)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			results := policy.Analyze(p, egressList)
+			if config.outputFormat == utils.OutputFormatJSON {
+				b, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(utils.ExitErrors)
+				}
+				fmt.Println(string(b))
+			} else {
+				printResults(results)
+			}
+
+			if slices.ContainsFunc(results, policy.Result.Blocked) {
+				os.Exit(utils.ExitFailures)
+			}
+		},
+	}
+
+	analyzePolicyCmd.Flags().StringVar(&config.platformType, "platform", cloud.AWSClassic.String(), fmt.Sprintf("(optional) infra platform type, which determines which egress list the policy is checked against. "+
+		"Either '%s', '%s', '%s', or '%s' (hypershift)", cloud.AWSClassic, cloud.GCPClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress))
+	analyzePolicyCmd.Flags().StringVar(&config.region, "region", "", fmt.Sprintf("(optional) region the cluster will be installed in. If absent, environment var %[1]v = %[2]v and %[3]v = %[4]v will be used", utils.AwsRegionEnvVarStr, utils.AwsRegionDefault, utils.GcpRegionEnvVarStr, utils.GcpRegionDefault))
+	analyzePolicyCmd.Flags().StringVar(&config.policyFormat, "format", "", fmt.Sprintf("(optional) format of the policy file. One of %s. Inferred from the file name if absent", strings.Join(policy.Formats, ", ")))
+	analyzePolicyCmd.Flags().StringVar(&config.egressListLocation, "egress-list-location", "", "(optional) local file path of an egress list to check the policy against instead of the platform's")
+	analyzePolicyCmd.Flags().StringArrayVar(&config.egressListOverlays, "egress-list-overlay", []string{}, "(optional) local file path of an egress list overlay adding, overriding or removing endpoints of the egress list. Can be repeated; overlays are applied in the order given")
+	analyzePolicyCmd.Flags().StringToStringVar(&config.egressListVariables, "egress-list-var", map[string]string{}, "(optional) value of a ${VAR} placeholder used in the egress list, e.g. --egress-list-var CLUSTER_NAME=my-cluster. Can be repeated")
+	analyzePolicyCmd.Flags().StringVarP(&config.outputFormat, "output", "o", utils.OutputFormatText, fmt.Sprintf("(optional) format of the results printed to stdout. Either '%s' (default) or '%s'", utils.OutputFormatText, utils.OutputFormatJSON))
+
+	return analyzePolicyCmd
+}
+
+// printResults prints every endpoint the policy wouldn't allow (unless another member of its anyOf
+// group is allowed), followed by a summary
+func printResults(results []policy.Result) {
+	var allowed, blocked, optionalBlocked, satisfied, unknown int
+	for _, result := range results {
+		label := "blocked"
+		switch {
+		case result.Decision == policy.DecisionAllow:
+			allowed++
+			continue
+		case result.SatisfiedBy != "":
+			// Another member of the endpoint's anyOf group is allowed, so this one isn't needed
+			satisfied++
+			continue
+		case result.Decision == policy.DecisionUnknown:
+			label = "unknown"
+			unknown++
+		case !result.Required:
+			label = "warning"
+			optionalBlocked++
+		default:
+			blocked++
+		}
+		protocol := result.Protocol
+		if !result.Required {
+			protocol += ", optional"
+		}
+		fmt.Printf("%s: %s:%d (%s): %s\n", label, result.Host, result.Port, protocol, result.Reason)
+	}
+	fmt.Printf("Summary: %d allowed, %d blocked, %d optional blocked, %d covered by an allowed alternative, %d unknown\n", allowed, blocked, optionalBlocked, satisfied, unknown)
+}
@@ -0,0 +1,85 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/openshift/osd-network-verifier/cmd/utils"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+type diffConfig struct {
+	outputFormat string
+}
+
+func NewCmdDiff() *cobra.Command {
+	config := diffConfig{}
+
+	diffCmd := &cobra.Command{
+		Use:   "diff <before.json> <after.json>",
+		Short: "Compare the results of two verifier runs saved with --output json",
+		Long: `Compare the results of two verifier runs saved with --output json, reporting which endpoints
+became blocked or reachable and which errors and exceptions appeared or were resolved.
+Exits non-zero if the second run has regressed, i.e., has any newly failing endpoints or new
+failures, exceptions, or errors.`,
+		Example: `./osd-network-verifier egress --subnet-id $SUBNET_ID -o json > before.json
+# ...change firewall rules...
+./osd-network-verifier egress --subnet-id $SUBNET_ID -o json > after.json
+./osd-network-verifier diff before.json after.json`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := utils.ValidateOutputFormat(config.outputFormat); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			before, err := readDocumentFile(args[0])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			after, err := readDocumentFile(args[1])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			d := output.DiffDocuments(before, after)
+			if config.outputFormat == utils.OutputFormatJSON {
+				b, err := json.MarshalIndent(d, "", "  ")
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(utils.ExitErrors)
+				}
+				fmt.Println(string(b))
+			} else {
+				fmt.Println("Summary:")
+				fmt.Print(d.Format())
+			}
+
+			if d.HasRegressions() {
+				os.Exit(utils.ExitFailures)
+			}
+		},
+	}
+
+	diffCmd.Flags().StringVarP(&config.outputFormat, "output", "o", utils.OutputFormatText, fmt.Sprintf("(optional) format of the comparison printed to stdout. Either '%s' (default) or '%s'", utils.OutputFormatText, utils.OutputFormatJSON))
+
+	return diffCmd
+}
+
+func readDocumentFile(path string) (*output.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, err := output.ReadDocument(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return doc, nil
+}
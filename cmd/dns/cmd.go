@@ -16,10 +16,12 @@ var (
 )
 
 type dnsConfig struct {
-	vpcID      string
-	debug      bool
-	region     string
-	awsProfile string
+	vpcID        string
+	debug        bool
+	region       string
+	awsProfile   string
+	outputFormat string
+	resultFlags  utils.ResultFlags
 }
 
 func getDefaultRegion() string {
@@ -38,11 +40,25 @@ func NewCmdValidateDns() *cobra.Command {
 		Use:   "dns",
 		Short: "Verify any prerequisite DNS configuration is set as expected",
 		Run: func(cmd *cobra.Command, args []string) {
+			if err := utils.ValidateOutputFormat(config.outputFormat); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if err := config.resultFlags.Validate(); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
 
 			awsVerifier, err := utils.GetAwsVerifier(os.Getenv("AWS_REGION"), config.awsProfile, config.debug)
 			if err != nil {
 				fmt.Println(err)
-				os.Exit(1)
+				os.Exit(utils.ExitCredentials)
+			}
+			if config.outputFormat == utils.OutputFormatJSON {
+				if awsVerifier.Logger, err = utils.NewStderrLogger(config.debug); err != nil {
+					fmt.Println(err)
+					os.Exit(utils.ExitErrors)
+				}
 			}
 			awsVerifier.Logger.Warn(context.TODO(), "Using region: %s", config.region)
 
@@ -51,13 +67,7 @@ func NewCmdValidateDns() *cobra.Command {
 				Ctx:   context.TODO(),
 			}
 			out := verifier.VerifyDns(awsVerifier, vdi)
-			out.Summary(config.debug)
-			if !out.IsSuccessful() {
-				awsVerifier.Logger.Error(context.TODO(), "Failure!")
-				os.Exit(1)
-			}
-
-			awsVerifier.Logger.Info(context.TODO(), "Success")
+			os.Exit(config.resultFlags.FinishRun(out, config.outputFormat, config.debug, awsVerifier.Logger))
 		},
 	}
 
@@ -65,6 +75,8 @@ func NewCmdValidateDns() *cobra.Command {
 	validateDnsCmd.Flags().StringVar(&config.region, "region", getDefaultRegion(), fmt.Sprintf("Region to validate. Defaults to exported var %[1]v or '%[2]v' if not %[1]v set", regionEnvVarStr, regionDefault))
 	validateDnsCmd.Flags().BoolVar(&config.debug, "debug", false, "If true, enable additional debug-level logging")
 	validateDnsCmd.Flags().StringVar(&config.awsProfile, "profile", "", "(optional) AWS profile. If present, any credentials passed with CLI will be ignored.")
+	utils.AddResultFlags(validateDnsCmd.Flags(), &config.resultFlags, "one test case per DNS attribute check")
+	validateDnsCmd.Flags().StringVarP(&config.outputFormat, "output", "o", utils.OutputFormatText, fmt.Sprintf("(optional) format of the results printed to stdout. Either '%s' (default) or '%s'", utils.OutputFormatText, utils.OutputFormatJSON))
 
 	if err := validateDnsCmd.MarkFlagRequired("vpc-id"); err != nil {
 		validateDnsCmd.PrintErr(err)
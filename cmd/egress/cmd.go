@@ -4,11 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"net/http"
-	"net/url"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -18,8 +14,11 @@ import (
 	"github.com/openshift/osd-network-verifier/cmd/utils"
 	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
 	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
-	"github.com/openshift/osd-network-verifier/pkg/probes/curl"
-	"github.com/openshift/osd-network-verifier/pkg/probes/legacy"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/openshift/osd-network-verifier/pkg/probes"
+	// Register the built-in probes
+	_ "github.com/openshift/osd-network-verifier/pkg/probes/curl"
+	_ "github.com/openshift/osd-network-verifier/pkg/probes/legacy"
 	"github.com/openshift/osd-network-verifier/pkg/proxy"
 	"github.com/openshift/osd-network-verifier/pkg/verifier"
 	gcpverifier "github.com/openshift/osd-network-verifier/pkg/verifier/gcp"
@@ -31,10 +30,7 @@ var (
 )
 
 const (
-	awsRegionEnvVarStr = "AWS_REGION"
-	awsRegionDefault   = "us-east-2"
-	gcpRegionEnvVarStr = "GCP_REGION"
-	gcpRegionDefault   = "us-east1"
+	defaultProbeName = "curl"
 )
 
 type egressConfig struct {
@@ -44,6 +40,9 @@ type egressConfig struct {
 	cpuArchName                string
 	securityGroupIDs           []string
 	egressListLocation         string
+	egressListVariables        map[string]string
+	egressListOverlays         []string
+	egressListFetchFlags       utils.EgressListFetchFlags
 	cloudTags                  map[string]string
 	debug                      bool
 	region                     string
@@ -62,6 +61,8 @@ type egressConfig struct {
 	importKeyPair              string
 	ForceTempSecurityGroup     bool
 	probeName                  string
+	outputFormat               string
+	resultFlags                utils.ResultFlags
 }
 
 func NewCmdValidateEgress() *cobra.Command {
@@ -78,16 +79,30 @@ are set correctly before execution.
 # Verify that essential OpenShift domains are reachable from a given SUBNET_ID/SECURITY_GROUP association
 ./osd-network-verifier egress --subnet-id ${SUBNET_ID} --security-group-ids ${SECURITY_GROUP}`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if err := utils.ValidateOutputFormat(config.outputFormat); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if err := config.resultFlags.Validate(); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if err := egress_lists.ValidateVariables(config.egressListVariables); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			jsonOutput := config.outputFormat == utils.OutputFormatJSON
+
 			platformType, err := cloud.ByName(config.platformType)
 			if err != nil {
 				//Unknown platformType specified
 				fmt.Println(err)
-				os.Exit(1)
+				os.Exit(utils.ExitInvalidConfiguration)
 			}
 
 			// Set Region
 			if config.region == "" {
-				config.region = getDefaultRegion(platformType)
+				config.region = utils.GetDefaultRegion(platformType)
 			}
 
 			// Set Up Proxy
@@ -96,7 +111,7 @@ are set correctly before execution.
 				cert, err := os.ReadFile(config.CaCert)
 				if err != nil {
 					fmt.Println(err)
-					os.Exit(1)
+					os.Exit(utils.ExitInvalidConfiguration)
 				}
 				// store string form of it
 				// this was agreed with sda that they'll be communicating it as a string.
@@ -113,14 +128,66 @@ are set correctly before execution.
 
 			// setup non cloud config options
 			vei := verifier.ValidateEgressInput{
-				Ctx:          context.TODO(),
-				SubnetID:     config.vpcSubnetID,
-				CloudImageID: config.cloudImageID,
-				Timeout:      config.timeout,
-				Tags:         config.cloudTags,
-				InstanceType: config.instanceType,
-				PlatformType: platformType,
-				Proxy:        p,
+				Ctx:                 context.TODO(),
+				SubnetID:            config.vpcSubnetID,
+				CloudImageID:        config.cloudImageID,
+				Timeout:             config.timeout,
+				Tags:                config.cloudTags,
+				InstanceType:        config.instanceType,
+				PlatformType:        platformType,
+				Proxy:               p,
+				EgressListVariables: config.egressListVariables,
+			}
+			vei.EgressListFetchOptions, err = config.egressListFetchFlags.Options()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			// Map specified CPU architecture name to cpu.Architecture type
+			vei.CPUArchitecture = cpu.ArchitectureByName(config.cpuArchName)
+			if config.cpuArchName != "" && !vei.CPUArchitecture.IsValid() {
+				// Unknown cpu.Architecture specified
+				fmt.Printf("unknown CPU architecture '%s'\n", config.cpuArchName)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			// Probe selection
+			probeName := config.probeName
+			if probeName == "" {
+				probeName = defaultProbeName
+			}
+			probe, err := probes.Lookup(probeName)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			err = probe.Check(probes.Requirements{
+				Platform:         platformType,
+				Architecture:     vei.CPUArchitecture,
+				CustomEgressList: config.egressListLocation != "" || len(config.egressListOverlays) > 0,
+				Proxy:            config.httpProxy != "" || config.httpsProxy != "",
+			})
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			vei.Probe = probe.New()
+
+			if config.egressListLocation != "" {
+				vei.EgressListYaml, err = utils.GetCustomEgressList(config.egressListLocation, vei.EgressListFetchOptions.Signatures)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(utils.ExitInvalidConfiguration)
+				}
+			}
+			for _, location := range config.egressListOverlays {
+				overlayYaml, err := utils.GetCustomEgressList(location, vei.EgressListFetchOptions.Signatures)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(utils.ExitInvalidConfiguration)
+				}
+				vei.EgressListOverlays = append(vei.EgressListOverlays, overlayYaml)
 			}
 
 			// AWS workflow
@@ -139,7 +206,13 @@ are set correctly before execution.
 				awsVerifier, err := utils.GetAwsVerifier(config.region, config.awsProfile, config.debug)
 				if err != nil {
 					fmt.Printf("could not build awsVerifier %v\n", err)
-					os.Exit(1)
+					os.Exit(utils.ExitCredentials)
+				}
+				if jsonOutput {
+					if awsVerifier.Logger, err = utils.NewStderrLogger(config.debug); err != nil {
+						fmt.Println(err)
+						os.Exit(utils.ExitErrors)
+					}
 				}
 
 				awsVerifier.Logger.Warn(context.TODO(), "Using region: %s", config.region)
@@ -149,39 +222,8 @@ are set correctly before execution.
 				vei.ImportKeyPair = config.importKeyPair
 				vei.ForceTempSecurityGroup = config.ForceTempSecurityGroup
 
-				// Probe selection
-				switch strings.ToLower(config.probeName) {
-				case "", "curl", "curlprobe", "curl.probe":
-					vei.Probe = curl.Probe{}
-					if config.egressListLocation != "" {
-						vei.EgressListYaml, err = getCustomEgressListFromFlag(config.egressListLocation)
-						if err != nil {
-							fmt.Println(err)
-							return
-						}
-					}
-				case "legacy", "legacyprobe", "legacy.probe":
-					vei.Probe = legacy.Probe{}
-				}
-
-				// Map specified CPU architecture name to cpu.Architecture type
-				vei.CPUArchitecture = cpu.ArchitectureByName(config.cpuArchName)
-				if config.cpuArchName != "" && !vei.CPUArchitecture.IsValid() {
-					// Unknown cpu.Architecture specified
-					fmt.Printf("unknown CPU architecture '%s'\n", config.cpuArchName)
-					os.Exit(1)
-				}
-
 				out := verifier.ValidateEgress(awsVerifier, vei)
-				out.Summary(config.debug)
-
-				if !out.IsSuccessful() {
-					awsVerifier.Logger.Error(context.TODO(), "Failure!")
-					os.Exit(1)
-				}
-
-				awsVerifier.Logger.Info(context.TODO(), "Success")
-				os.Exit(0)
+				os.Exit(config.resultFlags.FinishRun(out, config.outputFormat, config.debug, awsVerifier.Logger))
 			}
 
 			// GCP workflow
@@ -194,13 +236,13 @@ are set correctly before execution.
 				projectID := os.Getenv("GCP_PROJECT_ID")
 				if projectID == "" {
 					fmt.Println("please set environment variable GCP_PROJECT_ID to the project ID of the VPC")
-					os.Exit(1)
+					os.Exit(utils.ExitInvalidConfiguration)
 				}
 
 				vpcName := config.gcpVpcName
 				if vpcName == "" {
 					fmt.Println("please pass the flag --vpc-name=<VPC-NAME> to identify the VPC")
-					os.Exit(1)
+					os.Exit(utils.ExitInvalidConfiguration)
 				}
 
 				//Setup GCP Secific Configs
@@ -217,25 +259,23 @@ are set correctly before execution.
 				creds, err := google.FindDefaultCredentials(context.TODO())
 				if err != nil {
 					fmt.Printf("could not find GCP credentials file: %v\n", err)
-					os.Exit(1)
+					os.Exit(utils.ExitCredentials)
 				}
 				gcpVerifier, err := gcpverifier.NewGcpVerifier(creds, config.debug)
 				if err != nil {
 					fmt.Printf("could not build GcpVerifier: %v\n", err)
-					os.Exit(1)
+					os.Exit(utils.ExitCredentials)
+				}
+				if jsonOutput {
+					if gcpVerifier.Logger, err = utils.NewStderrLogger(config.debug); err != nil {
+						fmt.Println(err)
+						os.Exit(utils.ExitErrors)
+					}
 				}
 
 				gcpVerifier.Logger.Info(context.TODO(), "Using Project ID %s", vei.GCP.ProjectID)
 				out := verifier.ValidateEgress(gcpVerifier, vei)
-				out.Summary(config.debug)
-
-				if !out.IsSuccessful() {
-					gcpVerifier.Logger.Error(context.TODO(), "Failure!")
-					os.Exit(1)
-				}
-
-				gcpVerifier.Logger.Info(context.TODO(), "Success")
-				os.Exit(0)
+				os.Exit(config.resultFlags.FinishRun(out, config.outputFormat, config.debug, gcpVerifier.Logger))
 			}
 		},
 	}
@@ -247,8 +287,11 @@ are set correctly before execution.
 	validateEgressCmd.Flags().StringVar(&config.instanceType, "instance-type", "", "(optional) compute instance type")
 	validateEgressCmd.Flags().StringVar(&config.cpuArchName, "cpu-arch", "", "(optional) compute instance CPU architecture. Ignored if valid instance-type specified")
 	validateEgressCmd.Flags().StringSliceVar(&config.securityGroupIDs, "security-group-ids", []string{}, "(optional) comma-separated list of sec. group IDs to attach to the created EC2 instance. If absent, one will be created")
-	validateEgressCmd.Flags().StringVar(&config.egressListLocation, "egress-list-location", "", "(optional) the location of the egress URL list to use. Can either be a local file path or an external URL starting with http(s). This value is ignored for the legacy probe.")
-	validateEgressCmd.Flags().StringVar(&config.region, "region", "", fmt.Sprintf("(optional) compute instance region. If absent, environment var %[1]v = %[2]v and %[3]v = %[4]v will be used", awsRegionEnvVarStr, awsRegionDefault, gcpRegionEnvVarStr, gcpRegionDefault))
+	validateEgressCmd.Flags().StringVar(&config.egressListLocation, "egress-list-location", "", "(optional) the location of the egress URL list to use. Can either be a local file path or an external URL starting with http(s). Only supported by probes that honor custom egress lists")
+	validateEgressCmd.Flags().StringArrayVar(&config.egressListOverlays, "egress-list-overlay", []string{}, "(optional) the location of an egress list overlay adding, overriding or removing endpoints of the egress list. Can either be a local file path or an external URL starting with http(s). Can be repeated; overlays are applied in the order given. Only supported by probes that honor custom egress lists")
+	utils.AddEgressListFetchFlags(validateEgressCmd.Flags(), &config.egressListFetchFlags)
+	validateEgressCmd.Flags().StringToStringVar(&config.egressListVariables, "egress-list-var", map[string]string{}, "(optional) value of a ${VAR} placeholder used in the egress list, e.g. --egress-list-var CLUSTER_NAME=my-cluster. Can be repeated. See docs/egress-lists.md for the documented variables")
+	validateEgressCmd.Flags().StringVar(&config.region, "region", "", fmt.Sprintf("(optional) compute instance region. If absent, environment var %[1]v = %[2]v and %[3]v = %[4]v will be used", utils.AwsRegionEnvVarStr, utils.AwsRegionDefault, utils.GcpRegionEnvVarStr, utils.GcpRegionDefault))
 	validateEgressCmd.Flags().StringToStringVar(&config.cloudTags, "cloud-tags", map[string]string{}, "(optional) comma-seperated list of tags to assign to cloud resources e.g. --cloud-tags key1=value1,key2=value2")
 	validateEgressCmd.Flags().BoolVar(&config.debug, "debug", false, "(optional) if true, enable additional debug-level logging")
 	validateEgressCmd.Flags().DurationVar(&config.timeout, "timeout", time.Duration(0), "(optional) timeout for individual egress verification requests")
@@ -264,71 +307,12 @@ are set correctly before execution.
 	validateEgressCmd.Flags().StringVar(&config.terminateDebugInstance, "terminate-debug", "", "(optional) Takes the debug instance ID and terminates it")
 	validateEgressCmd.Flags().StringVar(&config.importKeyPair, "import-keypair", "", "(optional) Takes the path to your public key used to connect to Debug Instance. Automatically skips Termination")
 	validateEgressCmd.Flags().BoolVar(&config.ForceTempSecurityGroup, "force-temp-security-group", false, "(optional) Enforces creation of Temporary SG even if --security-group-ids flag is used")
-	validateEgressCmd.Flags().StringVar(&config.probeName, "probe", "Curl", "(optional) select the probe to be used for egress testing. Either 'Curl' (default) or 'Legacy'")
+	validateEgressCmd.Flags().StringVar(&config.probeName, "probe", defaultProbeName, fmt.Sprintf("(optional) select the probe to be used for egress testing. One of %s. Options the probe doesn't support (e.g., a platform, CPU architecture, custom egress list or proxy) are rejected", strings.Join(probes.Names(), ", ")))
+	utils.AddResultFlags(validateEgressCmd.Flags(), &config.resultFlags, "one test case per egress endpoint")
+	validateEgressCmd.Flags().StringVarP(&config.outputFormat, "output", "o", utils.OutputFormatText, fmt.Sprintf("(optional) format of the results printed to stdout. Either '%s' (default) or '%s'", utils.OutputFormatText, utils.OutputFormatJSON))
 	if err := validateEgressCmd.MarkFlagRequired("subnet-id"); err != nil {
 		validateEgressCmd.PrintErr(err)
 	}
 
 	return validateEgressCmd
 }
-
-func getDefaultRegion(platformType cloud.Platform) string {
-	switch platformType {
-	case cloud.GCPClassic:
-		dRegion, ok := os.LookupEnv(gcpRegionEnvVarStr)
-		if !ok {
-			return gcpRegionDefault
-		}
-		return dRegion
-	default: // All other platforms, but we assume AWS
-		dRegion, ok := os.LookupEnv(awsRegionEnvVarStr)
-		if !ok {
-			return awsRegionDefault
-		}
-		return dRegion
-	}
-}
-
-func getCustomEgressListFromFlag(location string) (string, error) {
-	var egressListYaml string
-	if _, err := os.Stat(location); err == nil {
-		egressListYaml, err = getCustomLocalEgressList(location)
-		if err != nil {
-			return "", fmt.Errorf("failed to fetch egress URL list from %s: %v", location, err)
-		}
-		absPath, _ := filepath.Abs(location) // if we've gotten this far, we know the path is valid
-		fmt.Printf("Using local egress list from %s\n", absPath)
-		return egressListYaml, nil
-	}
-
-	parsedUrl, err := url.ParseRequestURI(location)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse URL %s: %w", location, err)
-	}
-	egressListYaml, err = getCustomExternalEgressList(parsedUrl.String())
-	if err != nil {
-		return "", fmt.Errorf("failed to fetch egress URL list from %s: %w", parsedUrl.String(), err)
-	}
-	fmt.Printf("Using external egress list from %s\n", parsedUrl.String())
-	return egressListYaml, nil
-}
-
-func getCustomLocalEgressList(filePath string) (string, error) {
-	file, err := os.ReadFile(filePath)
-	if err != nil {
-		return "", err
-	}
-	return string(file), nil
-}
-
-func getCustomExternalEgressList(url string) (string, error) {
-	response, err := http.Get(url)
-	if err != nil {
-		return "", err
-	}
-	b, err := io.ReadAll(response.Body)
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
-}
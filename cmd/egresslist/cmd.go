@@ -0,0 +1,163 @@
+package egresslist
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/openshift/osd-network-verifier/cmd/utils"
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdEgressList returns the parent of the subcommands working with egress lists
+func NewCmdEgressList() *cobra.Command {
+	egressListCmd := &cobra.Command{
+		Use:   "egress-list",
+		Short: "Work with egress lists",
+		Long: `Work with the egress lists used by the egress subcommand's curl probe.
+See https://github.com/openshift/osd-network-verifier/blob/main/docs/egress-lists.md for the egress list format.`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			if err := cmd.Help(); err != nil {
+				cmd.PrintErr(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	egressListCmd.AddCommand(newCmdLint())
+	egressListCmd.AddCommand(newCmdPrint())
+	egressListCmd.AddCommand(newCmdExport())
+
+	return egressListCmd
+}
+
+type lintConfig struct {
+	platformType string
+	outputFormat string
+	variables    map[string]string
+}
+
+// lintResult holds the issues found in a single egress list
+type lintResult struct {
+	Path   string               `json:"path"`
+	Issues []egress_lists.Issue `json:"issues"`
+}
+
+func newCmdLint() *cobra.Command {
+	config := lintConfig{}
+
+	lintCmd := &cobra.Command{
+		Use:   "lint [file...]",
+		Short: "Validate egress lists",
+		Long: `Validate egress list files before passing them to the egress subcommand's --egress-list-location,
+reporting unknown keys, duplicate host/port pairs, invalid hostnames and ports, unresolved ${VAR}
+placeholders and schema version mismatches, along with the line they were found on. Placeholders
+are resolved if they reference variables documented for --platform or passed with --egress-list-var.
+If no files are given, validates the egress lists built into the verifier instead.
+Exits non-zero if any issues were found.`,
+		Example: `./osd-network-verifier egress-list lint my-egress-list.yaml
+./osd-network-verifier egress-list lint --platform aws-hcp my-egress-list.yaml`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := utils.ValidateOutputFormat(config.outputFormat); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			if err := egress_lists.ValidateVariables(config.variables); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			var platforms []cloud.Platform
+			if config.platformType != "" {
+				platform, err := cloud.ByName(config.platformType)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(utils.ExitInvalidConfiguration)
+				}
+				platforms = append(platforms, platform)
+			} else if len(args) == 0 {
+				platforms = []cloud.Platform{cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress, cloud.GCPClassic}
+			}
+
+			var results []lintResult
+			if len(args) == 0 {
+				for _, platform := range platforms {
+					egressListYaml, err := egress_lists.GetLocalEgressList(platform)
+					if err != nil {
+						fmt.Fprintln(os.Stderr, err)
+						os.Exit(utils.ExitInvalidConfiguration)
+					}
+					results = append(results, lintResult{
+						Path:   fmt.Sprintf("%s.yaml", platform),
+						Issues: egress_lists.Lint(egressListYaml, lintVariables(platform, config.variables)),
+					})
+				}
+			} else {
+				// Without --platform, accept the variables documented for any platform
+				variables := lintVariables(cloud.Platform{}, config.variables)
+				if len(platforms) > 0 {
+					variables = lintVariables(platforms[0], config.variables)
+				}
+				for _, path := range args {
+					b, err := os.ReadFile(path)
+					if err != nil {
+						fmt.Fprintln(os.Stderr, err)
+						os.Exit(utils.ExitInvalidConfiguration)
+					}
+					results = append(results, lintResult{
+						Path:   path,
+						Issues: egress_lists.Lint(string(b), variables),
+					})
+				}
+			}
+
+			var issueCount int
+			for _, result := range results {
+				issueCount += len(result.Issues)
+			}
+
+			if config.outputFormat == utils.OutputFormatJSON {
+				for i := range results {
+					if results[i].Issues == nil {
+						results[i].Issues = []egress_lists.Issue{}
+					}
+				}
+				b, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(utils.ExitErrors)
+				}
+				fmt.Println(string(b))
+			} else {
+				for _, result := range results {
+					for _, issue := range result.Issues {
+						fmt.Printf("%s:%d: %s\n", result.Path, issue.Line, issue.Message)
+					}
+				}
+				fmt.Printf("Found %d issue(s) in %d egress list(s)\n", issueCount, len(results))
+			}
+
+			if issueCount > 0 {
+				os.Exit(utils.ExitFailures)
+			}
+		},
+	}
+
+	lintCmd.Flags().StringVar(&config.platformType, "platform", "", "(optional) the platform the egress lists will be used with, determining which ${VAR} placeholders are defined. If no files are given, only the built-in egress list for this platform is validated")
+	lintCmd.Flags().StringToStringVar(&config.variables, "egress-list-var", map[string]string{}, "(optional) additional ${VAR} placeholder that may be used in the egress lists, in the same format as the egress subcommand's --egress-list-var. Only the names are used")
+	lintCmd.Flags().StringVarP(&config.outputFormat, "output", "o", utils.OutputFormatText, fmt.Sprintf("(optional) format of the issues printed to stdout. Either '%s' (default) or '%s'", utils.OutputFormatText, utils.OutputFormatJSON))
+
+	return lintCmd
+}
+
+// lintVariables returns the names of the variables documented for platformType, plus those in extra
+func lintVariables(platformType cloud.Platform, extra map[string]string) []string {
+	names := egress_lists.VariableNames(platformType)
+	for name := range extra {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,91 @@
+package egresslist
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/osd-network-verifier/cmd/utils"
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+)
+
+type exportConfig struct {
+	platformType        string
+	region              string
+	format              string
+	egressListLocation  string
+	egressListOverlays  []string
+	egressListVariables map[string]string
+}
+
+func newCmdExport() *cobra.Command {
+	config := exportConfig{}
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the egress list as firewall or proxy configuration",
+		Long: `Export the egress list built into the verifier for a platform and region, after expanding ${VAR}
+placeholders, applying overlays in order and skipping endpoints that don't apply to the region, in
+a format that can be used to allow its endpoints through a firewall or proxy:
+
+  yaml                  the resolved egress list
+  json, csv             one entry per host and port
+  squid                 squid.conf "acl dstdomain" allowlist, per set of ports
+  aws-network-firewall  AWS Network Firewall stateful domain list rule group
+  suricata              Suricata "pass" rules matching TLS SNI and HTTP Host headers
+  gcp-firewall          GCP network firewall policy rules matching destination FQDNs
+
+Endpoints that the format can't express (e.g., plain TCP endpoints in formats matching domain
+names) are left out and listed on stderr.`,
+		Example: `./osd-network-verifier egress-list export --platform aws-classic --region us-east-1 --format squid
+./osd-network-verifier egress-list export --format aws-network-firewall > rule-group.json`,
+		Args: cobra.NoArgs,
+		Run: func(_ *cobra.Command, _ []string) {
+			platformType, err := cloud.ByName(config.platformType)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if !slices.Contains(egress_lists.ExportFormats, config.format) {
+				fmt.Printf("unknown export format '%s', must be one of %s\n", config.format, strings.Join(egress_lists.ExportFormats, ", "))
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if err := egress_lists.ValidateVariables(config.egressListVariables); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if config.region == "" {
+				config.region = utils.GetDefaultRegion(platformType)
+			}
+
+			egressList, err := utils.ResolveLocalEgressList(platformType, config.region, config.egressListLocation, config.egressListOverlays, config.egressListVariables)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			skipped, err := egress_lists.Export(os.Stdout, egressList, config.format)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitErrors)
+			}
+			if len(skipped) > 0 {
+				fmt.Fprintf(os.Stderr, "Skipped %d endpoint(s) that can't be expressed in %s format: %s\n", len(skipped), config.format, strings.Join(skipped, ", "))
+			}
+		},
+	}
+
+	exportCmd.Flags().StringVar(&config.platformType, "platform", cloud.AWSClassic.String(), fmt.Sprintf("(optional) infra platform type, which determines which egress list to export. "+
+		"Either '%s', '%s', '%s', or '%s' (hypershift)", cloud.AWSClassic, cloud.GCPClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress))
+	exportCmd.Flags().StringVar(&config.region, "region", "", fmt.Sprintf("(optional) region the egress list will be used in. If absent, environment var %[1]v = %[2]v and %[3]v = %[4]v will be used", utils.AwsRegionEnvVarStr, utils.AwsRegionDefault, utils.GcpRegionEnvVarStr, utils.GcpRegionDefault))
+	exportCmd.Flags().StringVarP(&config.format, "format", "f", egress_lists.ExportFormatYAML, fmt.Sprintf("(optional) export format. One of %s", strings.Join(egress_lists.ExportFormats, ", ")))
+	exportCmd.Flags().StringVar(&config.egressListLocation, "egress-list-location", "", "(optional) local file path of an egress list to export instead of the platform's")
+	exportCmd.Flags().StringArrayVar(&config.egressListOverlays, "egress-list-overlay", []string{}, "(optional) local file path of an egress list overlay adding, overriding or removing endpoints of the egress list. Can be repeated; overlays are applied in the order given")
+	exportCmd.Flags().StringToStringVar(&config.egressListVariables, "egress-list-var", map[string]string{}, "(optional) value of a ${VAR} placeholder used in the egress list, e.g. --egress-list-var CLUSTER_NAME=my-cluster. Can be repeated")
+
+	return exportCmd
+}
@@ -0,0 +1,126 @@
+package egresslist
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/openshift/osd-network-verifier/cmd/utils"
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+)
+
+type printConfig struct {
+	platformType        string
+	region              string
+	egressListLocation  string
+	egressListOverlays  []string
+	egressListVariables map[string]string
+	fetchFlags          utils.EgressListFetchFlags
+}
+
+func newCmdPrint() *cobra.Command {
+	config := printConfig{}
+
+	printCmd := &cobra.Command{
+		Use:   "print",
+		Short: "Print the egress list that the egress subcommand would test",
+		Long: `Print the egress list that the egress subcommand would test with the same flags, after expanding
+${VAR} placeholders, applying overlays in order and skipping endpoints that don't apply to the region.`,
+		Example: `./osd-network-verifier egress-list print --platform aws-classic --region us-east-1 \
+    --egress-list-overlay internal-registries.yaml`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, _ []string) {
+			platformType, err := cloud.ByName(config.platformType)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if err := egress_lists.ValidateVariables(config.egressListVariables); err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			if config.region == "" {
+				config.region = utils.GetDefaultRegion(platformType)
+			}
+
+			fetchOptions, err := config.fetchFlags.Options()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			var fetched *egress_lists.FetchResult
+			if config.egressListLocation != "" {
+				egressListYaml, err := utils.GetCustomEgressList(config.egressListLocation, fetchOptions.Signatures)
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(utils.ExitInvalidConfiguration)
+				}
+				fetched = &egress_lists.FetchResult{Yaml: egressListYaml, Source: config.egressListLocation}
+			} else {
+				fetched, err = egress_lists.FetchEgressList(cmd.Context(), platformType, fetchOptions)
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(utils.ExitErrors)
+				}
+			}
+
+			var overlayYamls []string
+			for _, location := range config.egressListOverlays {
+				overlayYaml, err := utils.GetCustomEgressList(location, fetchOptions.Signatures)
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(utils.ExitInvalidConfiguration)
+				}
+				overlayYamls = append(overlayYamls, overlayYaml)
+			}
+
+			variables := utils.EgressListVariables(platformType, config.region, config.egressListVariables)
+
+			var egressList *egress_lists.EgressList
+			if config.egressListLocation != "" {
+				egressList, err = egress_lists.ParseEgressList(fetched.Yaml, variables)
+			} else {
+				egressList, err = fetched.Parse(platformType, variables)
+				if err == nil {
+					if fetched.FallbackReason != "" {
+						fmt.Fprintln(os.Stderr, fetched.FallbackReason)
+					}
+					fmt.Fprintf(os.Stderr, "Using %s\n", fetched.Description())
+					if fetched.Unverified {
+						fmt.Fprintf(os.Stderr, "The signature of the %s wasn't verified\n", fetched.Description())
+					}
+				}
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+			egressList, err = egressList.ApplyOverlays(overlayYamls, variables)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitInvalidConfiguration)
+			}
+
+			encoder := yaml.NewEncoder(os.Stdout)
+			encoder.SetIndent(2)
+			if err := encoder.Encode(egressList.ForRegion(config.region)); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(utils.ExitErrors)
+			}
+		},
+	}
+
+	printCmd.Flags().StringVar(&config.platformType, "platform", cloud.AWSClassic.String(), fmt.Sprintf("(optional) infra platform type, which determines which egress list to print. "+
+		"Either '%s', '%s', '%s', or '%s' (hypershift)", cloud.AWSClassic, cloud.GCPClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress))
+	printCmd.Flags().StringVar(&config.region, "region", "", fmt.Sprintf("(optional) region the egress list will be tested in. If absent, environment var %[1]v = %[2]v and %[3]v = %[4]v will be used", utils.AwsRegionEnvVarStr, utils.AwsRegionDefault, utils.GcpRegionEnvVarStr, utils.GcpRegionDefault))
+	printCmd.Flags().StringVar(&config.egressListLocation, "egress-list-location", "", "(optional) the location of the egress URL list to use instead of the platform's. Can either be a local file path or an external URL starting with http(s)")
+	printCmd.Flags().StringArrayVar(&config.egressListOverlays, "egress-list-overlay", []string{}, "(optional) the location of an egress list overlay adding, overriding or removing endpoints of the egress list. Can either be a local file path or an external URL starting with http(s). Can be repeated; overlays are applied in the order given")
+	utils.AddEgressListFetchFlags(printCmd.Flags(), &config.fetchFlags)
+	printCmd.Flags().StringToStringVar(&config.egressListVariables, "egress-list-var", map[string]string{}, "(optional) value of a ${VAR} placeholder used in the egress list, e.g. --egress-list-var CLUSTER_NAME=my-cluster. Can be repeated")
+
+	return printCmd
+}
@@ -3,8 +3,11 @@ package cmd
 import (
 	"flag"
 	"fmt"
+	"github.com/openshift/osd-network-verifier/cmd/analyzepolicy"
+	"github.com/openshift/osd-network-verifier/cmd/diff"
 	"github.com/openshift/osd-network-verifier/cmd/dns"
 	"github.com/openshift/osd-network-verifier/cmd/egress"
+	"github.com/openshift/osd-network-verifier/cmd/egresslist"
 	"github.com/openshift/osd-network-verifier/version"
 	"github.com/spf13/cobra"
 	"os"
@@ -27,7 +30,7 @@ For more information see https://github.com/openshift/osd-network-verifier/blob/
 		DisableAutoGenTag: true,
 		PersistentPreRun: func(*cobra.Command, []string) {
 			if opts.debug {
-				fmt.Printf("Version:\t%v\nCommit Hash:\t%v\n", version.Version, version.CommitHash)
+				fmt.Fprintf(os.Stderr, "Version:\t%v\nCommit Hash:\t%v\n", version.Version, version.CommitHash)
 			}
 		},
 		Run: help,
@@ -39,6 +42,9 @@ For more information see https://github.com/openshift/osd-network-verifier/blob/
 	// add sub commands
 	rootCmd.AddCommand(egress.NewCmdValidateEgress())
 	rootCmd.AddCommand(dns.NewCmdValidateDns())
+	rootCmd.AddCommand(diff.NewCmdDiff())
+	rootCmd.AddCommand(egresslist.NewCmdEgressList())
+	rootCmd.AddCommand(analyzepolicy.NewCmdAnalyzePolicy())
 
 	return rootCmd
 }
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"errors"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+)
+
+// Process exit codes. Wrapper scripts can use these to decide whether a problem needs to be
+// reported to the network's owner (ExitFailures), investigated (ExitExceptions), retried
+// (ExitErrors), or fixed in the verifier's own invocation (ExitInvalidConfiguration,
+// ExitCredentials). See docs/exit-codes.md
+const (
+	// ExitSuccess means all verification tests passed
+	ExitSuccess = 0
+	// ExitFailures means the network under test failed verification, e.g., an egress endpoint
+	// is blocked or a VPC attribute required for DNS is disabled
+	ExitFailures = 1
+	// ExitExceptions means no verification failures were found, but some tests couldn't run as
+	// expected (e.g., the probe's output was corrupted)
+	ExitExceptions = 2
+	// ExitErrors means the run was disrupted by infrastructure or cloud API errors and should be
+	// retried
+	ExitErrors = 3
+	// ExitInvalidConfiguration means the verifier was given invalid flags or input
+	ExitInvalidConfiguration = 4
+	// ExitCredentials means the verifier's cloud credentials are missing, invalid, or lack a
+	// required permission
+	ExitCredentials = 5
+)
+
+// ExitCodeForOutput derives the process exit code from the contents of out. When out contains
+// several kinds of problems, the one that most needs attention wins, in this order: credential
+// and permission problems, invalid configuration, verification failures, errors, exceptions
+func ExitCodeForOutput(out *output.Output) int {
+	if out.IsSuccessful() {
+		return ExitSuccess
+	}
+
+	failures, exceptions, errs := out.Parse()
+	categories := map[handledErrors.Category]bool{}
+	for _, err := range append(append([]error{}, exceptions...), errs...) {
+		categories[errorCategory(err)] = true
+	}
+
+	switch {
+	case categories[handledErrors.CategoryCredentials] || categories[handledErrors.CategoryIAM]:
+		return ExitCredentials
+	case categories[handledErrors.CategoryConfiguration]:
+		return ExitInvalidConfiguration
+	case len(failures) > 0 || categories[handledErrors.CategoryEgress] || categories[handledErrors.CategoryDNS]:
+		return ExitFailures
+	case len(errs) > 0:
+		return ExitErrors
+	default:
+		return ExitExceptions
+	}
+}
+
+func errorCategory(err error) handledErrors.Category {
+	var nve *handledErrors.GenericError
+	if errors.As(err, &nve) {
+		return nve.Category()
+	}
+	return handledErrors.NewGenericError(err).Category()
+}
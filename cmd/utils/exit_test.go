@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+)
+
+func TestExitCodeForOutput(t *testing.T) {
+	coded := func(code handledErrors.Code) error {
+		return handledErrors.NewGenericError(errors.New(string(code))).WithCode(code)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(*output.Output)
+		want  int
+	}{
+		{
+			name:  "success",
+			setup: func(*output.Output) {},
+			want:  ExitSuccess,
+		},
+		{
+			name:  "egress failures",
+			setup: func(o *output.Output) { o.SetEgressFailures([]string{"quay.io:443"}) },
+			want:  ExitFailures,
+		},
+		{
+			name: "egress failures and cleanup error",
+			setup: func(o *output.Output) {
+				o.SetEgressFailures([]string{"quay.io:443"})
+				o.AddError(coded(handledErrors.CodeCloudAPIError))
+			},
+			want: ExitFailures,
+		},
+		{
+			name:  "dns attribute disabled",
+			setup: func(o *output.Output) { o.AddException(coded(handledErrors.CodeDNSAttributeDisabled)) },
+			want:  ExitFailures,
+		},
+		{
+			name:  "exceptions only",
+			setup: func(o *output.Output) { o.AddException(coded(handledErrors.CodeProbeCorrupt)) },
+			want:  ExitExceptions,
+		},
+		{
+			name: "cloud api error",
+			setup: func(o *output.Output) {
+				o.AddException(coded(handledErrors.CodeProbeCorrupt))
+				o.AddError(errors.New("oops"))
+			},
+			want: ExitErrors,
+		},
+		{
+			name:  "invalid configuration",
+			setup: func(o *output.Output) { o.AddError(coded(handledErrors.CodeInvalidConfiguration)) },
+			want:  ExitInvalidConfiguration,
+		},
+		{
+			name: "missing permission",
+			setup: func(o *output.Output) {
+				o.AddError(coded(handledErrors.CodeInvalidConfiguration))
+				o.AddError(coded(handledErrors.CodeIAMPermissionDenied))
+			},
+			want: ExitCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &output.Output{}
+			tt.setup(out)
+			if got := ExitCodeForOutput(out); got != tt.want {
+				t.Errorf("ExitCodeForOutput() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,49 @@
 package utils
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
 
+	ocmlog "github.com/openshift-online/ocm-sdk-go/logging"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/openshift/osd-network-verifier/pkg/output"
 	awsverifier "github.com/openshift/osd-network-verifier/pkg/verifier/aws"
 )
 
+const (
+	// AwsRegionEnvVarStr is the environment variable holding the default region on AWS platforms
+	AwsRegionEnvVarStr = "AWS_REGION"
+	// AwsRegionDefault is the default region on AWS platforms when AwsRegionEnvVarStr isn't set
+	AwsRegionDefault = "us-east-2"
+	// GcpRegionEnvVarStr is the environment variable holding the default region on GCP
+	GcpRegionEnvVarStr = "GCP_REGION"
+	// GcpRegionDefault is the default region on GCP when GcpRegionEnvVarStr isn't set
+	GcpRegionDefault = "us-east1"
+	// gcpProjectIDEnvVarStr is the environment variable holding the GCP project ID used to expand
+	// egress lists outside of verifier runs
+	gcpProjectIDEnvVarStr = "GCP_PROJECT_ID"
+	// githubTokenEnvVarStr is the environment variable holding the default --github-token
+	githubTokenEnvVarStr = "GITHUB_TOKEN"
+	// OutputFormatText prints the human-readable summary produced by output.Output.Summary
+	OutputFormatText = "text"
+	// OutputFormatJSON prints the machine-readable document produced by output.Output.WriteTo
+	OutputFormatJSON = "json"
+)
+
 // GetAwsVerifier returns a verifier client from a profile or ENV vars if set
 func GetAwsVerifier(region, profile string, debug bool) (*awsverifier.AwsVerifier, error) {
 	accessKey := ""
@@ -23,3 +60,423 @@ func GetAwsVerifier(region, profile string, debug bool) (*awsverifier.AwsVerifie
 
 	return awsverifier.NewAwsVerifier(accessKey, secretAccessKey, sessionsToken, region, profile, debug)
 }
+
+// ValidateOutputFormat returns an error if format isn't one of the supported --output values
+func ValidateOutputFormat(format string) error {
+	switch format {
+	case OutputFormatText, OutputFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("unknown output format '%s', must be either '%s' or '%s'", format, OutputFormatText, OutputFormatJSON)
+	}
+}
+
+// NewStderrLogger builds an ocm logger that writes all levels to stderr. It's used in place of the
+// verifiers' default logger when a machine-readable output format is requested, so that stdout
+// contains nothing but the serialized output
+func NewStderrLogger(debug bool) (ocmlog.Logger, error) {
+	return ocmlog.NewStdLoggerBuilder().Streams(os.Stderr, os.Stderr).Debug(debug).Build()
+}
+
+// PrintOutput prints out to stdout in the requested format
+func PrintOutput(out *output.Output, format string, debug bool) error {
+	if format == OutputFormatJSON {
+		_, err := out.WriteTo(os.Stdout)
+		return err
+	}
+
+	out.Summary(debug)
+	return nil
+}
+
+// WriteJUnitFile writes out as a JUnit XML report to the file at path, creating or truncating it
+func WriteJUnitFile(out *output.Output, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create JUnit report file: %w", err)
+	}
+	defer f.Close()
+
+	if err := out.WriteJUnit(f); err != nil {
+		return fmt.Errorf("failed to write JUnit report to %s: %w", path, err)
+	}
+	return nil
+}
+
+// reportWriters maps each supported --report-file extension to the output method rendering it
+var reportWriters = map[string]func(*output.Output, io.Writer) error{
+	".html":     (*output.Output).WriteHTML,
+	".htm":      (*output.Output).WriteHTML,
+	".md":       (*output.Output).WriteMarkdown,
+	".markdown": (*output.Output).WriteMarkdown,
+}
+
+// ValidateReportFile returns an error if the report format can't be inferred from path's extension
+func ValidateReportFile(path string) error {
+	if _, ok := reportWriters[strings.ToLower(filepath.Ext(path))]; !ok {
+		return fmt.Errorf("unable to infer report format from '%s', file extension must be one of .html, .htm, .md or .markdown", path)
+	}
+	return nil
+}
+
+// WriteReportFile writes out as an HTML or Markdown report (depending on path's extension) to the
+// file at path, creating or truncating it
+func WriteReportFile(out *output.Output, path string) error {
+	if err := ValidateReportFile(path); err != nil {
+		return err
+	}
+	writeReport := reportWriters[strings.ToLower(filepath.Ext(path))]
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	defer f.Close()
+
+	if err := writeReport(out, f); err != nil {
+		return fmt.Errorf("failed to write report to %s: %w", path, err)
+	}
+	return nil
+}
+
+// ValidateMetricsFile returns an error if path wouldn't be read by node_exporter's textfile
+// collector, which ignores files without the .prom extension
+func ValidateMetricsFile(path string) error {
+	if filepath.Ext(path) != ".prom" {
+		return fmt.Errorf("metrics file '%s' must have the .prom extension to be read by node_exporter's textfile collector", path)
+	}
+	return nil
+}
+
+// WriteMetricsFile writes out's Prometheus metrics to the file at path. The metrics are first
+// written to a temporary file in the same directory, which is then renamed to path, so that the
+// textfile collector never reads a partially written file
+func WriteMetricsFile(out *output.Output, path string) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create metrics file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := out.WriteMetrics(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write metrics to %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write metrics to %s: %w", path, err)
+	}
+	// CreateTemp only grants access to the current user, but the collector may run as another
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write metrics to %s: %w", path, err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to write metrics to %s: %w", path, err)
+	}
+	return nil
+}
+
+// ValidateMetricsAddress returns an error if addr isn't a valid "host:port" listen address
+func ValidateMetricsAddress(addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("invalid metrics address '%s': %w", addr, err)
+	}
+	return nil
+}
+
+// ServeMetrics serves out's Prometheus metrics at /metrics on addr, blocking until the process
+// receives SIGINT or SIGTERM
+func ServeMetrics(out *output.Output, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", out.MetricsHandler())
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("failed to serve metrics on %s: %w", addr, err)
+	case <-ctx.Done():
+		return server.Shutdown(context.Background())
+	}
+}
+
+// ResultFlags holds the values of the flags registered by AddResultFlags
+type ResultFlags struct {
+	JUnitFile      string
+	ReportFile     string
+	MetricsFile    string
+	MetricsAddress string
+}
+
+// AddResultFlags registers the flags writing a run's results to files or serving them as metrics.
+// testCases describes the JUnit report's test cases, e.g., "one test case per egress endpoint"
+func AddResultFlags(flags *pflag.FlagSet, f *ResultFlags, testCases string) {
+	flags.StringVar(&f.JUnitFile, "junit-file", "", "(optional) path of a file to write a JUnit XML report to, with "+testCases)
+	flags.StringVar(&f.ReportFile, "report-file", "", "(optional) path of a file to write a human-readable report to, suitable for attaching to support cases. The format (HTML or Markdown) is inferred from the file extension: .html, .htm, .md or .markdown")
+	flags.StringVar(&f.MetricsFile, "metrics-file", "", "(optional) path of a .prom file to write Prometheus metrics to, for use with node_exporter's textfile collector")
+	flags.StringVar(&f.MetricsAddress, "metrics-address", "", "(optional) host:port to serve Prometheus metrics on at /metrics after the run completes. Blocks until interrupted (SIGINT or SIGTERM), then exits with the run's exit code")
+}
+
+// Validate returns an error if any of the flags is invalid
+func (f *ResultFlags) Validate() error {
+	if f.ReportFile != "" {
+		if err := ValidateReportFile(f.ReportFile); err != nil {
+			return err
+		}
+	}
+	if f.MetricsFile != "" {
+		if err := ValidateMetricsFile(f.MetricsFile); err != nil {
+			return err
+		}
+	}
+	if f.MetricsAddress != "" {
+		if err := ValidateMetricsAddress(f.MetricsAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FinishRun prints out in the given format, writes it to the files requested by f, logs whether
+// the run succeeded and then serves out's metrics if requested, which blocks until the process
+// receives SIGINT or SIGTERM. Failures to print, write or serve out are logged without affecting
+// the returned exit code, which is the run's (see ExitCodeForOutput)
+func (f *ResultFlags) FinishRun(out *output.Output, format string, debug bool, logger ocmlog.Logger) int {
+	ctx := context.TODO()
+	if err := PrintOutput(out, format, debug); err != nil {
+		logger.Error(ctx, "failed to print output: %s", err)
+	}
+	if f.JUnitFile != "" {
+		if err := WriteJUnitFile(out, f.JUnitFile); err != nil {
+			logger.Error(ctx, "%s", err)
+		}
+	}
+	if f.ReportFile != "" {
+		if err := WriteReportFile(out, f.ReportFile); err != nil {
+			logger.Error(ctx, "%s", err)
+		}
+	}
+	if f.MetricsFile != "" {
+		if err := WriteMetricsFile(out, f.MetricsFile); err != nil {
+			logger.Error(ctx, "%s", err)
+		}
+	}
+
+	exitCode := ExitCodeForOutput(out)
+	if exitCode != ExitSuccess {
+		logger.Error(ctx, "Failure!")
+	} else {
+		logger.Info(ctx, "Success")
+	}
+
+	if f.MetricsAddress != "" {
+		logger.Info(ctx, "Serving metrics at http://%s/metrics until interrupted", f.MetricsAddress)
+		if err := ServeMetrics(out, f.MetricsAddress); err != nil {
+			logger.Error(ctx, "%s", err)
+		}
+	}
+	return exitCode
+}
+
+// GetCustomEgressList returns the contents of the egress list (or egress list overlay) at location,
+// which may either be a local file path or an http(s) URL. Lists fetched from URLs must have a
+// detached signature at the URL with egress_lists.SignatureSuffix appended, which is verified
+// against signatures' trusted keys unless verification is skipped
+func GetCustomEgressList(location string, signatures egress_lists.SignatureOptions) (string, error) {
+	var egressListYaml string
+	if _, err := os.Stat(location); err == nil {
+		egressListYaml, err = getCustomLocalEgressList(location)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch egress URL list from %s: %v", location, err)
+		}
+		absPath, _ := filepath.Abs(location) // if we've gotten this far, we know the path is valid
+		fmt.Fprintf(os.Stderr, "Using local egress list from %s\n", absPath)
+		return egressListYaml, nil
+	}
+
+	parsedUrl, err := url.ParseRequestURI(location)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL %s: %w", location, err)
+	}
+	egressListYaml, err = getCustomExternalEgressList(parsedUrl.String(), signatures)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch egress URL list from %s: %w", parsedUrl.String(), err)
+	}
+	fmt.Fprintf(os.Stderr, "Using external egress list from %s\n", parsedUrl.String())
+	return egressListYaml, nil
+}
+
+// GetDefaultRegion returns the region to use on platformType when none was given: the value of the
+// platform's region environment variable, or else the platform's default region
+func GetDefaultRegion(platformType cloud.Platform) string {
+	switch platformType {
+	case cloud.GCPClassic:
+		dRegion, ok := os.LookupEnv(GcpRegionEnvVarStr)
+		if !ok {
+			return GcpRegionDefault
+		}
+		return dRegion
+	default: // All other platforms, but we assume AWS
+		dRegion, ok := os.LookupEnv(AwsRegionEnvVarStr)
+		if !ok {
+			return AwsRegionDefault
+		}
+		return dRegion
+	}
+}
+
+// EgressListVariables returns the same egress list variables as the verifier defines for
+// platformType in region, plus those in extra
+func EgressListVariables(platformType cloud.Platform, region string, extra map[string]string) map[string]string {
+	variables := map[string]string{}
+	if platformType == cloud.GCPClassic {
+		variables[egress_lists.VariableGCPRegion] = region
+		variables[egress_lists.VariableGCPProjectID] = os.Getenv(gcpProjectIDEnvVarStr)
+	} else {
+		variables[egress_lists.VariableAWSRegion] = region
+	}
+	maps.Copy(variables, extra)
+	return variables
+}
+
+// ResolveLocalEgressList returns the egress list built into the verifier for platformType (or the
+// list in the local file at location, if set), after applying the local overlay files in order and
+// skipping endpoints that don't apply to region. Unlike GetCustomEgressList, it never touches the
+// network
+func ResolveLocalEgressList(platformType cloud.Platform, region, location string, overlays []string, extraVariables map[string]string) (*egress_lists.EgressList, error) {
+	egressListYaml, err := egress_lists.GetLocalEgressList(platformType)
+	if location != "" {
+		egressListYaml, err = getCustomLocalEgressList(location)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var overlayYamls []string
+	for _, path := range overlays {
+		overlayYaml, err := getCustomLocalEgressList(path)
+		if err != nil {
+			return nil, err
+		}
+		overlayYamls = append(overlayYamls, overlayYaml)
+	}
+
+	variables := EgressListVariables(platformType, region, extraVariables)
+	egressList, err := egress_lists.ParseEgressList(egressListYaml, variables)
+	if err != nil {
+		return nil, err
+	}
+	egressList, err = egressList.ApplyOverlays(overlayYamls, variables)
+	if err != nil {
+		return nil, err
+	}
+	return egressList.ForRegion(region), nil
+}
+
+func getCustomLocalEgressList(filePath string) (string, error) {
+	file, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return string(file), nil
+}
+
+func getCustomExternalEgressList(url string, signatures egress_lists.SignatureOptions) (string, error) {
+	b, err := httpGet(url)
+	if err != nil {
+		return "", err
+	}
+	if signatures.InsecureSkipVerify {
+		return string(b), nil
+	}
+	if len(signatures.TrustedKeys) == 0 {
+		return "", egress_lists.ErrNoTrustedKeys
+	}
+
+	signature, err := httpGet(url + egress_lists.SignatureSuffix)
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%w: no signature found at %s%s", egress_lists.ErrUnsigned, url, egress_lists.SignatureSuffix)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch signature: %w", err)
+	}
+	if err := signatures.Verify(b, signature); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// httpStatusError is returned by httpGet for non-2xx responses
+type httpStatusError struct {
+	statusCode int
+	status     string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected response status %s", e.status)
+}
+
+// httpGet returns the body of a GET request to url, or an error if the response isn't a 2xx
+func httpGet(url string) ([]byte, error) {
+	response, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, &httpStatusError{statusCode: response.StatusCode, status: response.Status}
+	}
+	return io.ReadAll(response.Body)
+}
+
+// EgressListFetchFlags holds the values of the flags registered by AddEgressListFetchFlags
+type EgressListFetchFlags struct {
+	egress_lists.FetchOptions
+	// TrustedKeyFiles lists the files holding the public keys egress lists may be signed with
+	TrustedKeyFiles []string
+}
+
+// AddEgressListFetchFlags registers the flags controlling how egress lists are fetched from GitHub
+// or URLs, and how their signatures are verified. Use f.Options once flags are parsed
+func AddEgressListFetchFlags(flags *pflag.FlagSet, f *EgressListFetchFlags) {
+	defaultCacheDir := ""
+	if userCacheDir, err := os.UserCacheDir(); err == nil {
+		defaultCacheDir = filepath.Join(userCacheDir, "osd-network-verifier")
+	}
+
+	flags.StringVar(&f.Ref, "egress-list-ref", "", "(optional) git branch, tag or commit SHA of the platform's egress list to fetch from GitHub. Defaults to the default branch. If set, fails rather than falling back to the embedded list")
+	flags.StringVar(&f.CacheDir, "egress-list-cache-dir", defaultCacheDir, "(optional) directory in which egress lists fetched from GitHub are cached, and used if GitHub can't be reached. Set to '' to disable caching")
+	flags.BoolVar(&f.Offline, "offline", false, "(optional) only use the egress lists embedded in the verifier, without contacting GitHub")
+	flags.StringVar(&f.Token, "github-token", "", fmt.Sprintf("(optional) GitHub token used to fetch egress lists, avoiding the rate limits of anonymous requests. Defaults to the %s environment variable", githubTokenEnvVarStr))
+	flags.DurationVar(&f.Timeout, "egress-list-fetch-timeout", egress_lists.DefaultFetchTimeout, "(optional) timeout for fetching egress lists from GitHub")
+	flags.StringArrayVar(&f.TrustedKeyFiles, "egress-list-trusted-key", []string{}, fmt.Sprintf("(optional) file holding PEM-encoded ed25519 public keys that egress lists fetched from GitHub or URLs may be signed with. "+
+		"Signatures are fetched from the list's location with %s appended. Can be repeated. Without trusted keys, lists from GitHub can't be verified, so the embedded list is used (or the run fails if --egress-list-ref is set), and lists from URLs are rejected", egress_lists.SignatureSuffix))
+	flags.BoolVar(&f.Signatures.InsecureSkipVerify, "insecure-skip-egress-list-verification", false, "(optional) use egress lists fetched from GitHub or URLs without verifying their signatures. This is the only way to use them without trusted keys")
+}
+
+// Options returns the egress list fetch options set by flags, loading the trusted keys from their
+// files and filling in the options left unset from the environment
+func (f *EgressListFetchFlags) Options() (egress_lists.FetchOptions, error) {
+	opts := f.FetchOptions
+	if opts.Token == "" {
+		opts.Token = os.Getenv(githubTokenEnvVarStr)
+	}
+	for _, path := range f.TrustedKeyFiles {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return opts, fmt.Errorf("failed to read trusted egress list key: %w", err)
+		}
+		keys, err := egress_lists.ParsePublicKeys(b)
+		if err != nil {
+			return opts, fmt.Errorf("invalid trusted egress list key %s: %w", path, err)
+		}
+		opts.Signatures.TrustedKeys = append(opts.Signatures.TrustedKeys, keys...)
+	}
+	return opts, nil
+}
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+)
+
+func TestGetCustomEgressList(t *testing.T) {
+	const egressListYaml = "endpoints:\n  - host: quay.io\n    ports: [443]\n"
+	key := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	trusted := egress_lists.SignatureOptions{TrustedKeys: []ed25519.PublicKey{key.Public().(ed25519.PublicKey)}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/signed.yaml", func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(egressListYaml)) })
+	mux.HandleFunc("/signed.yaml.sig", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(ed25519.Sign(key, []byte(egressListYaml)))
+	})
+	mux.HandleFunc("/unsigned.yaml", func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(egressListYaml)) })
+	mux.HandleFunc("/tampered.yaml", func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(egressListYaml + "# tampered\n")) })
+	mux.HandleFunc("/tampered.yaml.sig", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(ed25519.Sign(key, []byte(egressListYaml)))
+	})
+	mux.HandleFunc("/error.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, egressListYaml, http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		path       string
+		signatures egress_lists.SignatureOptions
+		wantErr    bool
+	}{
+		{
+			name:       "signed",
+			path:       "/signed.yaml",
+			signatures: trusted,
+		},
+		{
+			name:       "unsigned",
+			path:       "/unsigned.yaml",
+			signatures: trusted,
+			wantErr:    true,
+		},
+		{
+			name:       "unsigned with verification skipped",
+			path:       "/unsigned.yaml",
+			signatures: egress_lists.SignatureOptions{InsecureSkipVerify: true},
+		},
+		{
+			name:       "tampered",
+			path:       "/tampered.yaml",
+			signatures: trusted,
+			wantErr:    true,
+		},
+		{
+			name:    "no trusted keys",
+			path:    "/signed.yaml",
+			wantErr: true,
+		},
+		{
+			name:       "server error",
+			path:       "/error.yaml",
+			signatures: egress_lists.SignatureOptions{InsecureSkipVerify: true},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetCustomEgressList(server.URL+tt.path, tt.signatures)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetCustomEgressList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != egressListYaml {
+				t.Errorf("GetCustomEgressList() = %q, want %q", got, egressListYaml)
+			}
+		})
+	}
+}
+
+func TestGetDefaultRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform cloud.Platform
+		env      map[string]string
+		want     string
+	}{
+		{name: "aws default", platform: cloud.AWSClassic, want: AwsRegionDefault},
+		{name: "aws env", platform: cloud.AWSHCP, env: map[string]string{AwsRegionEnvVarStr: "eu-west-1"}, want: "eu-west-1"},
+		{name: "gcp default", platform: cloud.GCPClassic, env: map[string]string{AwsRegionEnvVarStr: "eu-west-1"}, want: GcpRegionDefault},
+		{name: "gcp env", platform: cloud.GCPClassic, env: map[string]string{GcpRegionEnvVarStr: "europe-west1"}, want: "europe-west1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{AwsRegionEnvVarStr, GcpRegionEnvVarStr} {
+				t.Setenv(name, "")
+				os.Unsetenv(name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			if got := GetDefaultRegion(tt.platform); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResultFlags_FinishRun(t *testing.T) {
+	dir := t.TempDir()
+	f := ResultFlags{
+		JUnitFile:   filepath.Join(dir, "junit.xml"),
+		ReportFile:  filepath.Join(dir, "report.md"),
+		MetricsFile: filepath.Join(dir, "metrics.prom"),
+	}
+	if err := f.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	logger, err := NewStderrLogger(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	defer func() { os.Stdout = stdout }()
+	if os.Stdout, err = os.OpenFile(os.DevNull, os.O_WRONLY, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	out := &output.Output{}
+	out.SetEgressFailures([]string{"quay.io:443"})
+	if code := f.FinishRun(out, OutputFormatJSON, false, logger); code != ExitFailures {
+		t.Errorf("FinishRun() = %d, want %d", code, ExitFailures)
+	}
+	for _, path := range []string{f.JUnitFile, f.ReportFile, f.MetricsFile} {
+		if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+			t.Errorf("expected %s to be written, got %v", path, err)
+		}
+	}
+
+	if err := (&ResultFlags{MetricsAddress: "9090"}).Validate(); err == nil {
+		t.Error("expected an error for a metrics address without a port")
+	}
+}
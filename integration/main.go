@@ -6,15 +6,15 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 	"time"
 
 	ocmlog "github.com/openshift-online/ocm-sdk-go/logging"
 	inttestaws "github.com/openshift/osd-network-verifier/integration/pkg/aws"
 	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
 	"github.com/openshift/osd-network-verifier/pkg/probes"
-	"github.com/openshift/osd-network-verifier/pkg/probes/curl"
-	"github.com/openshift/osd-network-verifier/pkg/probes/legacy"
+	// Register the built-in probes
+	_ "github.com/openshift/osd-network-verifier/pkg/probes/curl"
+	_ "github.com/openshift/osd-network-verifier/pkg/probes/legacy"
 	"github.com/openshift/osd-network-verifier/pkg/verifier"
 	awsverifier "github.com/openshift/osd-network-verifier/pkg/verifier/aws"
 
@@ -135,14 +135,12 @@ func onvEgressCheck(cfg aws.Config, platform cloud.Platform, probe probes.Probe,
 	return nil
 }
 
-// GetProbeByName selects an implementation of the probes.Probe interface based on an
-// input string. Some variance in naming is allowed for convenience
+// GetProbeByName selects an implementation of the probes.Probe interface registered under
+// the given name or alias. Names are matched case-insensitively
 func GetProbeByName(probeName string) (probes.Probe, error) {
-	switch strings.ToLower(probeName) {
-	case "curl", "curlprobe", "curl.probe":
-		return curl.Probe{}, nil
-	case "legacy", "legacyprobe", "legacy.probe":
-		return legacy.Probe{}, nil
+	registration, err := probes.Lookup(probeName)
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("'%s' does not match any known probes", probeName)
+	return registration.New(), nil
 }
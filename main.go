@@ -4,10 +4,12 @@ import (
 	"os"
 
 	"github.com/openshift/osd-network-verifier/cmd"
+	"github.com/openshift/osd-network-verifier/cmd/utils"
 )
 
 func main() {
+	// Cobra only returns an error here for invalid usage, e.g., unknown flags or missing arguments
 	if err := cmd.NewCmdRoot().Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(utils.ExitInvalidConfiguration)
 	}
 }
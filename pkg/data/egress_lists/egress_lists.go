@@ -11,7 +11,15 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"net"
 	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/go-github/v63/github"
 	"gopkg.in/yaml.v3"
@@ -50,6 +58,9 @@ func GetLocalEgressList(platformType cloud.Platform) (string, error) {
 	}
 }
 
+// GetGithubEgressList fetches platformType's egress list from the default branch on GitHub.
+//
+// Deprecated: use FetchEgressList, which supports pinning, caching, authentication and timeouts
 func GetGithubEgressList(platformType cloud.Platform) (*github.RepositoryContent, error) {
 	ghClient := github.NewClient(nil)
 	path := "/pkg/data/egress_lists/"
@@ -73,57 +84,604 @@ func GetGithubEgressList(platformType cloud.Platform) (*github.RepositoryContent
 	return fileContentResponse, err
 }
 
-// EgressListToString returns two strings, the sum of which contains all the URLs
-// within a given platformType's egress list.
-// The first string returned contains all the URLs with tlsDisabled=false,
-// while the second string contains all URLs with tlsDisabled=true
-func EgressListToString(egressListYamlStr string, variables map[string]string) (string, string, error) {
-	variableMapper := func(varName string) string {
-		return variables[varName]
+// Egress list schema versions. Lists declare their version in a top-level "version" field
+const (
+	// SchemaVersionV1 is the original schema, supporting only each endpoint's host, ports and
+	// tlsDisabled. Lists without a version field are assumed to use it
+	SchemaVersionV1 = "v1"
+	// SchemaVersionV2 adds fields documenting each endpoint, and allows endpoints to be marked as
+	// optional using "required: false"
+	SchemaVersionV2 = "v2"
+)
+
+// Endpoint protocols. Endpoints declare their protocol in a "protocol" field, which defaults to
+// ProtocolHTTP for port 80, ProtocolHTTPS for port 443 and ProtocolTCP for all other ports
+const (
+	// ProtocolHTTP sends an HTTP request to the endpoint
+	ProtocolHTTP = "http"
+	// ProtocolHTTPS sends an HTTP request to the endpoint over TLS
+	ProtocolHTTPS = "https"
+	// ProtocolTCP only opens a TCP connection to the endpoint
+	ProtocolTCP = "tcp"
+	// ProtocolTLS completes a TLS handshake with the endpoint, without requiring it to speak HTTP
+	ProtocolTLS = "tls"
+)
+
+// wildcardPrefix starts wildcard hosts, e.g., "*.apps.example.com"
+const wildcardPrefix = "*."
+
+// Named address ranges that may be listed in an endpoint's ExpectedAddresses, alongside CIDRs
+const (
+	// AddressPrivate matches private addresses, per RFC 1918 (IPv4) and RFC 4193 (IPv6)
+	AddressPrivate = "private"
+	// AddressPublic matches every address that isn't private, loopback, link-local or unspecified
+	AddressPublic = "public"
+)
+
+// protocols lists all supported endpoint protocols
+var protocols = []string{ProtocolHTTP, ProtocolHTTPS, ProtocolTCP, ProtocolTLS}
+
+// DefaultMethod is the HTTP method used to request http(s) endpoints that don't declare a method
+const DefaultMethod = "HEAD"
+
+// MaxRetries is the largest number of retries an endpoint may declare
+const MaxRetries = 10
+
+// MinTimeout is the shortest timeout an endpoint may declare, as curl's timeouts are passed with a
+// precision of 10ms
+const MinTimeout = 10 * time.Millisecond
+
+var (
+	// methodPattern matches HTTP methods
+	methodPattern = regexp.MustCompile(`^[A-Z]+$`)
+	// urlOptionsPattern matches the URLs prefixed with request options returned by
+	// EgressList.ToString, e.g., "GET,timeout:30,retries:5=https://api.openshift.com:443/healthz"
+	urlOptionsPattern = regexp.MustCompile(`^((?:[A-Z]+|timeout:[0-9.]+|retries:[0-9]+)(?:,(?:[A-Z]+|timeout:[0-9.]+|retries:[0-9]+))*)=(.+)$`)
+)
+
+// awsRegionPattern matches the names of AWS regions, e.g., "us-east-1" or "us-gov-west-1"
+var awsRegionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+// awsPartitionPrefixes maps the region name prefixes of each non-commercial AWS partition to the
+// partition's name. Longer prefixes must come first
+var awsPartitionPrefixes = []struct {
+	prefix, partition string
+}{
+	{"us-isob-", "aws-iso-b"},
+	{"us-isof-", "aws-iso-f"},
+	{"eu-isoe-", "aws-iso-e"},
+	{"us-iso-", "aws-iso"},
+	{"us-gov-", "aws-us-gov"},
+	{"cn-", "aws-cn"},
+}
+
+// awsPartitions lists the names of all AWS partitions
+var awsPartitions = []string{"aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b", "aws-iso-e", "aws-iso-f"}
+
+// AWSPartition returns the name of the AWS partition (e.g., "aws" or "aws-us-gov") containing
+// region, or an empty string if region isn't the name of an AWS region
+func AWSPartition(region string) string {
+	if !awsRegionPattern.MatchString(region) {
+		return ""
+	}
+	for _, p := range awsPartitionPrefixes {
+		if strings.HasPrefix(region, p.prefix) {
+			return p.partition
+		}
+	}
+	return "aws"
+}
+
+// EgressList is a parsed egress list
+type EgressList struct {
+	// Version is the schema version the list was written against. Empty for v1 lists
+	Version   string     `yaml:"version,omitempty"`
+	Endpoints []Endpoint `yaml:"endpoints"`
+}
+
+// Endpoint is a single host (and its ports) that must be reachable from the network under test
+type Endpoint struct {
+	Host        string `yaml:"host"`
+	Ports       []int  `yaml:"ports"`
+	TLSDisabled bool   `yaml:"tlsDisabled,omitempty"`
+
+	// The following fields require SchemaVersionV2
+
+	// Category broadly groups endpoints by purpose, e.g., "registry" or "telemetry"
+	Category string `yaml:"category,omitempty"`
+	// Owner is the team or component that needs the endpoint
+	Owner       string `yaml:"owner,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	// Docs links to documentation explaining why the endpoint is needed
+	Docs string `yaml:"docs,omitempty"`
+	// Required defaults to true. Failures to reach endpoints with "required: false" are reported as
+	// warnings rather than failures
+	Required *bool `yaml:"required,omitempty"`
+
+	// Regions limits the endpoint to runs in the listed regions. Entries may be glob patterns
+	// (see path.Match), e.g., "ap-southeast-*". Empty means all regions
+	Regions []string `yaml:"regions,omitempty"`
+	// ExcludeRegions skips the endpoint in runs in the listed regions (or glob patterns)
+	ExcludeRegions []string `yaml:"excludeRegions,omitempty"`
+	// Partitions limits the endpoint to runs in the listed AWS partitions, e.g., "aws" or
+	// "aws-us-gov". Empty means all partitions
+	Partitions []string `yaml:"partitions,omitempty"`
+
+	// Protocol is the protocol used to test the endpoint's ports, one of ProtocolHTTP,
+	// ProtocolHTTPS, ProtocolTCP or ProtocolTLS. Defaults to http for port 80, https for port 443
+	// and tcp for all other ports
+	Protocol string `yaml:"protocol,omitempty"`
+	// Path is the URL path requested from http(s) endpoints, e.g., "/healthz". Defaults to "/"
+	Path string `yaml:"path,omitempty"`
+	// Method is the HTTP method used to request http(s) endpoints. Defaults to DefaultMethod
+	Method string `yaml:"method,omitempty"`
+	// ExpectedStatus lists the HTTP status codes http(s) endpoints may respond with. Empty means any
+	// response is accepted
+	ExpectedStatus []int `yaml:"expectedStatus,omitempty"`
+
+	// Samples lists the subdomains probed in place of the "*" of a wildcard host such as
+	// "*.s3.${AWS_REGION}.amazonaws.com", since wildcards can't be probed directly. E.g., sample
+	// "my-bucket" probes "my-bucket.s3.us-east-1.amazonaws.com". Required for wildcard hosts
+	Samples []string `yaml:"samples,omitempty"`
+
+	// AnyOf names the group of alternative endpoints (e.g., mirrors or CDNs) the endpoint belongs
+	// to. Endpoints with the same AnyOf form a group that passes as long as any of its members do
+	AnyOf string `yaml:"anyOf,omitempty"`
+
+	// ExpectedAddresses lists the address ranges the endpoint must be reached at: AddressPrivate,
+	// AddressPublic or CIDRs, e.g., "${VPC_CIDR}". Endpoints reached at an address outside all of
+	// them fail. Empty means any address is accepted
+	ExpectedAddresses []string `yaml:"expectedAddresses,omitempty"`
+
+	// Timeout overrides the probe's timeout for each attempt to reach the endpoint, e.g., "30s"
+	Timeout string `yaml:"timeout,omitempty"`
+	// Retries overrides the number of times the probe retries reaching the endpoint after a
+	// transient failure (up to MaxRetries)
+	Retries *int `yaml:"retries,omitempty"`
+}
+
+// IsRequired returns true unless the endpoint was explicitly marked as optional
+func (e Endpoint) IsRequired() bool {
+	return e.Required == nil || *e.Required
+}
+
+// AppliesTo returns true if the endpoint's region and partition selectors match region. Endpoints
+// with a Partitions selector never match regions outside AWS
+func (e Endpoint) AppliesTo(region string) bool {
+	if len(e.Regions) > 0 && !matchesRegion(e.Regions, region) {
+		return false
+	}
+	if matchesRegion(e.ExcludeRegions, region) {
+		return false
+	}
+	if len(e.Partitions) > 0 && !slices.Contains(e.Partitions, AWSPartition(region)) {
+		return false
+	}
+	return true
+}
+
+// matchesRegion returns true if region matches any of patterns
+func matchesRegion(patterns []string, region string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, region); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWildcard returns true if the endpoint's host is a wildcard, e.g., "*.apps.example.com"
+func (e Endpoint) IsWildcard() bool {
+	return strings.HasPrefix(e.Host, wildcardPrefix)
+}
+
+// Hosts returns the hosts probed for the endpoint: the sample hosts of a wildcard, or else its host
+func (e Endpoint) Hosts() []string {
+	if !e.IsWildcard() {
+		return []string{e.Host}
+	}
+	hosts := make([]string, 0, len(e.Samples))
+	for _, sample := range e.Samples {
+		hosts = append(hosts, sample+strings.TrimPrefix(e.Host, "*"))
+	}
+	return hosts
+}
+
+// ProtocolFor returns the protocol used to test port: the endpoint's Protocol if set, otherwise
+// http for port 80, https for port 443 and tcp for all other ports
+func (e Endpoint) ProtocolFor(port int) string {
+	switch {
+	case e.Protocol != "":
+		return e.Protocol
+	case port == 80:
+		return ProtocolHTTP
+	case port == 443:
+		return ProtocolHTTPS
+	default:
+		return ProtocolTCP
+	}
+}
+
+// URLs returns the curl-compatible URL of each of the endpoint's ports, for each of its Hosts. tcp
+// endpoints use the telnet scheme (i.e., a plain TCP connection), and tls endpoints use https, as
+// curl can't complete a TLS handshake without also sending a request. Only http(s) URLs include the
+// endpoint's Path
+func (e Endpoint) URLs() []string {
+	urls := []string{}
+	for _, host := range e.Hosts() {
+		for _, port := range e.Ports {
+			urls = append(urls, e.url(host, port))
+		}
+	}
+	return urls
+}
+
+// url returns the curl-compatible URL of host's port (see URLs)
+func (e Endpoint) url(host string, port int) string {
+	scheme := e.ProtocolFor(port)
+	path := e.Path
+	switch scheme {
+	case ProtocolTCP:
+		scheme = "telnet"
+		path = ""
+	case ProtocolTLS:
+		scheme = ProtocolHTTPS
+		path = ""
+	}
+	return fmt.Sprintf("%s://%s:%d%s", scheme, host, port, path)
+}
+
+// isHTTP returns true if the endpoint's port is tested with an HTTP request whose response is
+// checked, i.e., its protocol is http or https
+func (e Endpoint) isHTTP(port int) bool {
+	protocol := e.ProtocolFor(port)
+	return protocol == ProtocolHTTP || protocol == ProtocolHTTPS
+}
+
+// validate returns an error if the endpoint's host, samples, protocol, request options or
+// expectations are invalid
+// validateVersion returns an error if the endpoint sets fields requiring SchemaVersionV2 (see
+// v2Fields) in a list or overlay of another version, which Lint reports as well
+func (e Endpoint) validateVersion(version string) error {
+	if version == SchemaVersionV2 {
+		return nil
+	}
+	var fields []string
+	value := reflect.ValueOf(e)
+	for i := 0; i < value.NumField(); i++ {
+		name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("yaml"), ",")
+		if v2Fields[name] && !value.Field(i).IsZero() {
+			fields = append(fields, name)
+		}
+	}
+	if len(fields) > 0 {
+		return fmt.Errorf("endpoint %s sets %s, which require 'version: %s'", e.Host, strings.Join(fields, ", "), SchemaVersionV2)
+	}
+	return nil
+}
+
+func (e Endpoint) validate() error {
+	if strings.Contains(strings.TrimPrefix(e.Host, wildcardPrefix), "*") {
+		return fmt.Errorf("endpoint %s has an invalid wildcard, only '%s' is allowed as the host's first label", e.Host, wildcardPrefix)
+	}
+	if e.IsWildcard() && len(e.Samples) == 0 {
+		return fmt.Errorf("wildcard endpoint %s has no samples to probe", e.Host)
+	}
+	if !e.IsWildcard() && len(e.Samples) > 0 {
+		return fmt.Errorf("endpoint %s has samples, but isn't a wildcard", e.Host)
+	}
+	for _, sample := range e.Samples {
+		if sample == "" || strings.ContainsAny(sample, "* \t\n") {
+			return fmt.Errorf("wildcard endpoint %s has invalid sample '%s'", e.Host, sample)
+		}
+	}
+	if e.Protocol != "" && !slices.Contains(protocols, e.Protocol) {
+		return fmt.Errorf("endpoint %s has unknown protocol '%s', must be one of %s", e.Host, e.Protocol, strings.Join(protocols, ", "))
+	}
+	if e.Path != "" && (!strings.HasPrefix(e.Path, "/") || strings.ContainsAny(e.Path, " \t\n")) {
+		return fmt.Errorf("endpoint %s has invalid path '%s', must start with '/' and contain no whitespace", e.Host, e.Path)
+	}
+	if e.Method != "" && !methodPattern.MatchString(e.Method) {
+		return fmt.Errorf("endpoint %s has invalid method '%s', must be an uppercase HTTP method", e.Host, e.Method)
 	}
-	buf := []byte(os.Expand(egressListYamlStr, variableMapper))
+	for _, status := range e.ExpectedStatus {
+		if status < 100 || status > 599 {
+			return fmt.Errorf("endpoint %s has invalid expected status %d, must be between 100 and 599", e.Host, status)
+		}
+	}
+	for _, addresses := range e.ExpectedAddresses {
+		if err := validateAddressRange(addresses); err != nil {
+			return fmt.Errorf("endpoint %s has invalid expected addresses: %w", e.Host, err)
+		}
+	}
+	if e.Timeout != "" {
+		if timeout, err := time.ParseDuration(e.Timeout); err != nil || timeout < MinTimeout {
+			return fmt.Errorf("endpoint %s has invalid timeout '%s', must be a duration of at least %v such as '30s'", e.Host, e.Timeout, MinTimeout)
+		}
+	}
+	if e.Retries != nil && (*e.Retries < 0 || *e.Retries > MaxRetries) {
+		return fmt.Errorf("endpoint %s has invalid retries %d, must be between 0 and %d", e.Host, *e.Retries, MaxRetries)
+	}
+	return nil
+}
 
-	endpoints := reachabilityConfig{}
-	err := yaml.Unmarshal(buf, &endpoints)
+// validateAddressRange returns an error unless addresses is AddressPrivate, AddressPublic or a CIDR
+func validateAddressRange(addresses string) error {
+	if addresses == AddressPrivate || addresses == AddressPublic {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(addresses); err != nil {
+		return fmt.Errorf("'%s' must be '%s', '%s' or a CIDR", addresses, AddressPrivate, AddressPublic)
+	}
+	return nil
+}
+
+// matchesAddressRange returns true if ip is within addresses, a range validated by
+// validateAddressRange
+func matchesAddressRange(addresses string, ip net.IP) bool {
+	switch addresses {
+	case AddressPrivate:
+		return ip.IsPrivate()
+	case AddressPublic:
+		return !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsUnspecified()
+	}
+	_, network, err := net.ParseCIDR(addresses)
+	return err == nil && network.Contains(ip)
+}
+
+// expectsAddress returns true if the endpoint accepts being reached at remoteIP
+func (e Endpoint) expectsAddress(remoteIP string) bool {
+	ip := net.ParseIP(remoteIP)
+	if ip == nil {
+		return false
+	}
+	for _, addresses := range e.ExpectedAddresses {
+		if matchesAddressRange(addresses, ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseEgressList expands the ${VAR} placeholders in egressListYamlStr using variables and parses
+// the result as a v1 or v2 egress list. It returns an error if any placeholder references a
+// variable that's missing from variables or empty, as it would otherwise expand to a broken hostname
+func ParseEgressList(egressListYamlStr string, variables map[string]string) (*EgressList, error) {
+	buf, err := expandVariables(egressListYamlStr, variables)
 	if err != nil {
-		return "", "", err
+		return nil, err
+	}
+
+	egressList := &EgressList{}
+	if err := yaml.Unmarshal(buf, egressList); err != nil {
+		return nil, err
+	}
+	switch egressList.Version {
+	case "", SchemaVersionV1, SchemaVersionV2:
+	default:
+		return nil, fmt.Errorf("unsupported egress list schema version '%s', must be either '%s' or '%s'", egressList.Version, SchemaVersionV1, SchemaVersionV2)
+	}
+	for _, endpoint := range egressList.Endpoints {
+		if err := endpoint.validateVersion(egressList.Version); err != nil {
+			return nil, err
+		}
+		if err := endpoint.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return egressList, nil
+}
+
+// expandVariables replaces the ${VAR} placeholders in yamlStr with their values in variables,
+// returning an error if any of them is missing or empty
+func expandVariables(yamlStr string, variables map[string]string) ([]byte, error) {
+	var undefined []string
+	variableMapper := func(varName string) string {
+		value := variables[varName]
+		if value == "" && !slices.Contains(undefined, varName) {
+			undefined = append(undefined, varName)
+		}
+		return value
+	}
+	buf := []byte(os.Expand(yamlStr, variableMapper))
+	if len(undefined) > 0 {
+		return nil, fmt.Errorf("egress list references undefined variables: %s", strings.Join(undefined, ", "))
+	}
+	return buf, nil
+}
+
+// ForRegion returns a copy of the list containing only the endpoints whose region and partition
+// selectors match region (see Endpoint.AppliesTo)
+func (l *EgressList) ForRegion(region string) *EgressList {
+	filtered := &EgressList{Version: l.Version}
+	for _, endpoint := range l.Endpoints {
+		if endpoint.AppliesTo(region) {
+			filtered.Endpoints = append(filtered.Endpoints, endpoint)
+		}
+	}
+	return filtered
+}
+
+// WithDefaultExpectedAddresses returns a copy of the list in which endpoints that don't declare
+// ExpectedAddresses expect addresses instead, e.g., to require private addresses on zero-egress
+// platforms
+func (l *EgressList) WithDefaultExpectedAddresses(addresses ...string) *EgressList {
+	defaulted := &EgressList{Version: l.Version, Endpoints: slices.Clone(l.Endpoints)}
+	for i := range defaulted.Endpoints {
+		if len(defaulted.Endpoints[i].ExpectedAddresses) == 0 {
+			defaulted.Endpoints[i].ExpectedAddresses = addresses
+			// expectedAddresses requires v2, which is otherwise backwards compatible
+			defaulted.Version = SchemaVersionV2
+		}
 	}
+	return defaulted
+}
+
+// ToString returns two strings, the sum of which contains all the URLs within the egress list.
+// The first string returned contains all the URLs with tlsDisabled=false,
+// while the second string contains all URLs with tlsDisabled=true.
+// URLs requested with a method other than DefaultMethod, or with their own timeout or retry count,
+// are prefixed with those options and "=", e.g., "GET,timeout:30=https://api.openshift.com:443/healthz"
+// (see ParseURLEntry)
+func (l *EgressList) ToString() (string, string) {
 	// Build curl-compatible string of URLs
 	var urlListStr string
 	var tlsDisabledURLListStr string
-	for _, endpoint := range endpoints.Endpoints {
-		for _, port := range endpoint.Ports {
-			var protocol string
-			switch port {
-			case 80:
-				protocol = "http"
-			case 443:
-				protocol = "https"
-			default:
-				protocol = "telnet"
+	for _, endpoint := range l.Endpoints {
+		for _, host := range endpoint.Hosts() {
+			for _, port := range endpoint.Ports {
+				url := endpoint.url(host, port)
+				if options := endpoint.urlOptions(port); len(options) > 0 {
+					url = strings.Join(options, ",") + "=" + url
+				}
+				if endpoint.TLSDisabled {
+					tlsDisabledURLListStr += url + " "
+					continue
+				}
+				urlListStr += url + " "
 			}
-			urlStr := fmt.Sprintf("%s://%s:%d ", protocol, endpoint.Host, port)
+		}
+	}
+	return urlListStr, tlsDisabledURLListStr
+}
+
+// urlOptions returns the options prefixed to the URLs of the endpoint's port by ToString
+func (e Endpoint) urlOptions(port int) []string {
+	var options []string
+	if e.Method != "" && e.Method != DefaultMethod && e.isHTTP(port) {
+		options = append(options, e.Method)
+	}
+	if timeout, err := time.ParseDuration(e.Timeout); err == nil && timeout > 0 {
+		options = append(options, "timeout:"+strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64))
+	}
+	if e.Retries != nil {
+		options = append(options, "retries:"+strconv.Itoa(*e.Retries))
+	}
+	return options
+}
 
-			if endpoint.TLSDisabled {
-				tlsDisabledURLListStr += urlStr
-				continue
+// URLEntry is an entry of the strings returned by ToString
+type URLEntry struct {
+	URL string
+	// Method is the HTTP method the URL is requested with
+	Method string
+	// Timeout overrides the probe's timeout for each attempt to reach the URL, if non-zero
+	Timeout time.Duration
+	// Retries overrides the probe's retry count for the URL, if non-nil
+	Retries *int
+}
+
+// ParseURLEntry parses an entry of the strings returned by ToString into its URL and request
+// options. The method is DefaultMethod for URLs without a method prefix
+func ParseURLEntry(entry string) URLEntry {
+	parsed := URLEntry{URL: entry, Method: DefaultMethod}
+	match := urlOptionsPattern.FindStringSubmatch(entry)
+	if match == nil {
+		return parsed
+	}
+	parsed.URL = match[2]
+	for _, option := range strings.Split(match[1], ",") {
+		name, value, _ := strings.Cut(option, ":")
+		switch name {
+		case "timeout":
+			seconds, _ := strconv.ParseFloat(value, 64)
+			parsed.Timeout = time.Duration(seconds * float64(time.Second))
+		case "retries":
+			retries, _ := strconv.Atoi(value)
+			parsed.Retries = &retries
+		default:
+			parsed.Method = option
+		}
+	}
+	return parsed
+}
+
+// Lookup returns the first endpoint in the list with the given host and port, if any. host may
+// either be a wildcard host or one of its sample hosts
+func (l *EgressList) Lookup(host string, port int) (Endpoint, bool) {
+	for _, endpoint := range l.Endpoints {
+		if endpoint.Host != host && !slices.Contains(endpoint.Hosts(), host) {
+			continue
+		}
+		for _, p := range endpoint.Ports {
+			if p == port {
+				return endpoint, true
 			}
-			urlListStr += urlStr
 		}
 	}
-	return urlListStr, tlsDisabledURLListStr, nil
+	return Endpoint{}, false
 }
 
-// endpoint type (as it appears in the current YAML schema)
-// Borrowed from osd-network-verifier-golden-ami/build/bin/network-validator.go
-type endpoint struct {
-	Host        string `yaml:"host"`
-	Ports       []int  `yaml:"ports"`
-	TLSDisabled bool   `yaml:"tlsDisabled"`
+// EndpointMetadata is what an egress list says about one of its endpoints, as tested at a given port
+type EndpointMetadata struct {
+	// Required is false if failing to reach the endpoint shouldn't fail verification
+	Required bool
+	// Wildcard is the endpoint's wildcard host, if it has one
+	Wildcard string
+	AnyOf    string
+	// Protocol is the protocol the endpoint is tested with at the port
+	Protocol          string
+	ExpectedStatus    []int
+	ExpectedAddresses []string
+	Category          string
+	Owner             string
+	Description       string
+	Docs              string
+}
+
+// ExpectsAddress returns true if the endpoint accepts being reached at remoteIP, i.e., if it
+// doesn't list any expected addresses or remoteIP is one of them
+func (m EndpointMetadata) ExpectsAddress(remoteIP string) bool {
+	return len(m.ExpectedAddresses) == 0 || Endpoint{ExpectedAddresses: m.ExpectedAddresses}.expectsAddress(remoteIP)
+}
+
+// lookupURL returns the endpoint a probe result was recorded for: the first endpoint with a URL
+// matching url, or else the first endpoint with the given host and port
+func (l *EgressList) lookupURL(url, host string, port int) (Endpoint, bool) {
+	for _, endpoint := range l.Endpoints {
+		for _, endpointURL := range endpoint.URLs() {
+			// Probes report tcp URLs rather than curl's telnet ones
+			if strings.Replace(endpointURL, "telnet", ProtocolTCP, 1) == url {
+				return endpoint, true
+			}
+		}
+	}
+	return l.Lookup(host, port)
+}
+
+// Annotate returns what the list says about the endpoint a probe reported testing at url (i.e.,
+// host and port), or false if the list doesn't contain it
+func (l *EgressList) Annotate(url, host string, port int) (EndpointMetadata, bool) {
+	endpoint, ok := l.lookupURL(url, host, port)
+	if !ok {
+		return EndpointMetadata{}, false
+	}
+	md := EndpointMetadata{
+		Required:          endpoint.IsRequired(),
+		AnyOf:             endpoint.AnyOf,
+		Protocol:          endpoint.ProtocolFor(port),
+		ExpectedStatus:    endpoint.ExpectedStatus,
+		ExpectedAddresses: endpoint.ExpectedAddresses,
+		Category:          endpoint.Category,
+		Owner:             endpoint.Owner,
+		Description:       endpoint.Description,
+		Docs:              endpoint.Docs,
+	}
+	if endpoint.IsWildcard() {
+		md.Wildcard = endpoint.Host
+	}
+	return md, true
 }
 
-// reachabilityConfig list type (as it appears in the current YAML schema)
-// Borrowed from osd-network-verifier-golden-ami/build/bin/network-validator.go
-type reachabilityConfig struct {
-	Endpoints []endpoint `yaml:"endpoints"`
+// EgressListToString returns two strings, the sum of which contains all the URLs
+// within a given platformType's egress list that apply to region.
+// The first string returned contains all the URLs with tlsDisabled=false,
+// while the second string contains all URLs with tlsDisabled=true
+func EgressListToString(egressListYamlStr string, variables map[string]string, region string) (string, string, error) {
+	egressList, err := ParseEgressList(egressListYamlStr, variables)
+	if err != nil {
+		return "", "", err
+	}
+	urlListStr, tlsDisabledURLListStr := egressList.ForRegion(region).ToString()
+	return urlListStr, tlsDisabledURLListStr, nil
 }
@@ -0,0 +1,517 @@
+package egress_lists
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+)
+
+func TestParseEgressList(t *testing.T) {
+	optional := false
+	tests := []struct {
+		name    string
+		yaml    string
+		want    *EgressList
+		wantErr bool
+	}{
+		{
+			name: "v1 list without version",
+			yaml: `
+endpoints:
+  - host: ec2.${AWS_REGION}.amazonaws.com
+    ports:
+      - 443
+  - host: splunk.example.com
+    ports:
+      - 9997
+    tlsDisabled: true
+`,
+			want: &EgressList{Endpoints: []Endpoint{
+				{Host: "ec2.us-east-1.amazonaws.com", Ports: []int{443}},
+				{Host: "splunk.example.com", Ports: []int{9997}, TLSDisabled: true},
+			}},
+		},
+		{
+			name: "v2 list",
+			yaml: `
+version: v2
+endpoints:
+  - host: console.redhat.com
+    ports:
+      - 443
+    category: telemetry
+    owner: insights
+    description: Receives cluster telemetry
+    docs: https://docs.example.com/telemetry
+    required: false
+`,
+			want: &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+				{
+					Host:        "console.redhat.com",
+					Ports:       []int{443},
+					Category:    "telemetry",
+					Owner:       "insights",
+					Description: "Receives cluster telemetry",
+					Docs:        "https://docs.example.com/telemetry",
+					Required:    &optional,
+				},
+			}},
+		},
+		{
+			name: "protocol, path, method and expected status",
+			yaml: `
+version: v2
+endpoints:
+  - host: api.openshift.com
+    ports:
+      - 443
+    path: /api/upgrades_info/v1/graph
+    method: GET
+    expectedStatus:
+      - 200
+  - host: mirror.example.com
+    ports:
+      - 8443
+    protocol: tls
+`,
+			want: &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+				{Host: "api.openshift.com", Ports: []int{443}, Path: "/api/upgrades_info/v1/graph", Method: "GET", ExpectedStatus: []int{200}},
+				{Host: "mirror.example.com", Ports: []int{8443}, Protocol: ProtocolTLS},
+			}},
+		},
+		{
+			name: "wildcard host",
+			yaml: `
+version: v2
+endpoints:
+  - host: "*.s3.${AWS_REGION}.amazonaws.com"
+    ports:
+      - 443
+    samples:
+      - my-bucket
+      - my-bucket.s3-accesspoint
+`,
+			want: &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+				{Host: "*.s3.us-east-1.amazonaws.com", Ports: []int{443}, Samples: []string{"my-bucket", "my-bucket.s3-accesspoint"}},
+			}},
+		},
+		{
+			name:    "wildcard host without samples",
+			yaml:    "version: v2\nendpoints:\n  - host: '*.apps.example.com'\n    ports: [443]\n",
+			wantErr: true,
+		},
+		{
+			name:    "samples without wildcard host",
+			yaml:    "version: v2\nendpoints:\n  - host: apps.example.com\n    ports: [443]\n    samples: [console]\n",
+			wantErr: true,
+		},
+		{
+			name:    "wildcard in the middle of the host",
+			yaml:    "version: v2\nendpoints:\n  - host: 'apps.*.example.com'\n    ports: [443]\n    samples: [console]\n",
+			wantErr: true,
+		},
+		{
+			name:    "wildcard sample",
+			yaml:    "version: v2\nendpoints:\n  - host: '*.apps.example.com'\n    ports: [443]\n    samples: ['*']\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown protocol",
+			yaml:    "version: v2\nendpoints:\n  - host: quay.io\n    ports: [443]\n    protocol: quic\n",
+			wantErr: true,
+		},
+		{
+			name:    "path with whitespace",
+			yaml:    "version: v2\nendpoints:\n  - host: quay.io\n    ports: [443]\n    path: /v2/ http://evil.example.com\n",
+			wantErr: true,
+		},
+		{
+			name: "expected addresses",
+			yaml: "version: v2\nendpoints:\n  - host: sts.${AWS_REGION}.amazonaws.com\n    ports: [443]\n    expectedAddresses: [private, 100.64.0.0/10]\n",
+			want: &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+				{Host: "sts.us-east-1.amazonaws.com", Ports: []int{443}, ExpectedAddresses: []string{AddressPrivate, "100.64.0.0/10"}},
+			}},
+		},
+		{
+			name:    "invalid expected addresses",
+			yaml:    "version: v2\nendpoints:\n  - host: quay.io\n    ports: [443]\n    expectedAddresses: [internal]\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid timeout",
+			yaml:    "version: v2\nendpoints:\n  - host: quay.io\n    ports: [443]\n    timeout: 30\n",
+			wantErr: true,
+		},
+		{
+			name:    "v2 fields without version",
+			yaml:    "endpoints:\n  - host: quay.io\n    ports: [443]\n    timeout: 30s\n    anyOf: registries\n",
+			wantErr: true,
+		},
+		{
+			name:    "v2 field in v1 list",
+			yaml:    "version: v1\nendpoints:\n  - host: quay.io\n    ports: [443]\n    expectedStatus: [200]\n",
+			wantErr: true,
+		},
+		{
+			name:    "timeout below minimum",
+			yaml:    "version: v2\nendpoints:\n  - host: quay.io\n    ports: [443]\n    timeout: 5ms\n",
+			wantErr: true,
+		},
+		{
+			name:    "too many retries",
+			yaml:    "version: v2\nendpoints:\n  - host: quay.io\n    ports: [443]\n    retries: 11\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown version",
+			yaml:    "version: v9\nendpoints: []\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid yaml",
+			yaml:    "endpoints: [",
+			wantErr: true,
+		},
+		{
+			name:    "undefined variable",
+			yaml:    "endpoints:\n  - host: api.${CLUSTER_NAME}.${BASE_DOMAIN}\n    ports:\n      - 443\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEgressList(tt.yaml, map[string]string{"AWS_REGION": "us-east-1"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEgressListToString(t *testing.T) {
+	urls, tlsDisabledURLs, err := EgressListToString(`
+version: v2
+endpoints:
+  - host: example.com
+    ports:
+      - 80
+      - 443
+  - host: splunk.example.com
+    ports:
+      - 9997
+    tlsDisabled: true
+  - host: iam.us-gov.amazonaws.com
+    ports:
+      - 443
+    partitions:
+      - aws-us-gov
+  - host: api.openshift.com
+    ports:
+      - 443
+      - 8443
+    path: /healthz
+    method: GET
+  - host: mirror.example.com
+    ports:
+      - 8443
+    protocol: tls
+    tlsDisabled: true
+  - host: registry.example.com
+    ports:
+      - 5000
+    protocol: http
+    path: /v2/
+  - host: "*.apps.example.com"
+    ports:
+      - 80
+      - 443
+    samples:
+      - console
+      - oauth
+  - host: slow.example.com
+    ports:
+      - 443
+    timeout: 1m30s
+    retries: 0
+`, nil, "us-east-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "http://example.com:80 https://example.com:443 GET=https://api.openshift.com:443/healthz telnet://api.openshift.com:8443 http://registry.example.com:5000/v2/ " +
+		"http://console.apps.example.com:80 https://console.apps.example.com:443 http://oauth.apps.example.com:80 https://oauth.apps.example.com:443 " +
+		"timeout:90,retries:0=https://slow.example.com:443 "; urls != want {
+		t.Errorf("expected %q, got %q", want, urls)
+	}
+	if want := "telnet://splunk.example.com:9997 https://mirror.example.com:8443 "; tlsDisabledURLs != want {
+		t.Errorf("expected %q, got %q", want, tlsDisabledURLs)
+	}
+}
+
+func TestParseURLEntry(t *testing.T) {
+	retries := 5
+	tests := []struct {
+		entry string
+		want  URLEntry
+	}{
+		{entry: "https://quay.io:443", want: URLEntry{URL: "https://quay.io:443", Method: DefaultMethod}},
+		{entry: "timeout:2.5=https://quay.io:443", want: URLEntry{URL: "https://quay.io:443", Method: DefaultMethod, Timeout: 2500 * time.Millisecond}},
+		{
+			entry: "GET,timeout:30,retries:5=https://api.openshift.com:443/healthz?a=b",
+			want:  URLEntry{URL: "https://api.openshift.com:443/healthz?a=b", Method: "GET", Timeout: 30 * time.Second, Retries: &retries},
+		},
+		{entry: "timeout:fast=https://quay.io:443", want: URLEntry{URL: "timeout:fast=https://quay.io:443", Method: DefaultMethod}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.entry, func(t *testing.T) {
+			if got := ParseURLEntry(tt.entry); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseURLEntry() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAWSPartition(t *testing.T) {
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{region: "us-east-1", want: "aws"},
+		{region: "ap-southeast-5", want: "aws"},
+		{region: "us-gov-west-1", want: "aws-us-gov"},
+		{region: "cn-north-1", want: "aws-cn"},
+		{region: "us-iso-east-1", want: "aws-iso"},
+		{region: "us-isob-east-1", want: "aws-iso-b"},
+		{region: "us-east1", want: ""},
+		{region: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			if got := AWSPartition(tt.region); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEndpoint_AppliesTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint Endpoint
+		region   string
+		want     bool
+	}{
+		{
+			name:     "no selectors",
+			endpoint: Endpoint{Host: "quay.io"},
+			region:   "us-gov-west-1",
+			want:     true,
+		},
+		{
+			name:     "listed region",
+			endpoint: Endpoint{Regions: []string{"us-east-1", "us-west-2"}},
+			region:   "us-west-2",
+			want:     true,
+		},
+		{
+			name:     "unlisted region",
+			endpoint: Endpoint{Regions: []string{"us-east-1", "us-west-2"}},
+			region:   "eu-west-1",
+			want:     false,
+		},
+		{
+			name:     "region pattern",
+			endpoint: Endpoint{Regions: []string{"ap-*"}},
+			region:   "ap-east-1",
+			want:     true,
+		},
+		{
+			name:     "excluded region",
+			endpoint: Endpoint{ExcludeRegions: []string{"ap-east-1", "me-*"}},
+			region:   "me-south-1",
+			want:     false,
+		},
+		{
+			name:     "listed partition",
+			endpoint: Endpoint{Partitions: []string{"aws-us-gov"}},
+			region:   "us-gov-east-1",
+			want:     true,
+		},
+		{
+			name:     "unlisted partition",
+			endpoint: Endpoint{Partitions: []string{"aws"}},
+			region:   "us-gov-east-1",
+			want:     false,
+		},
+		{
+			name:     "partition outside AWS",
+			endpoint: Endpoint{Partitions: []string{"aws"}},
+			region:   "us-east1",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.endpoint.AppliesTo(tt.region); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEgressList_Annotate(t *testing.T) {
+	optional := false
+	egressList := &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+		{Host: "quay.io", Ports: []int{443}},
+		{Host: "console.redhat.com", Ports: []int{80, 443}, Category: "telemetry", Required: &optional},
+		{Host: "api.openshift.com", Ports: []int{443}, Path: "/healthz", ExpectedStatus: []int{200, 204}},
+		{Host: "api.openshift.com", Ports: []int{443}, Description: "API"},
+		{Host: "mirror.example.com", Ports: []int{8443}, Protocol: ProtocolTLS},
+		{Host: "*.apps.example.com", Ports: []int{443}, Samples: []string{"console", "oauth"}, AnyOf: "apps"},
+		{Host: "sts.us-east-1.amazonaws.com", Ports: []int{443}, ExpectedAddresses: []string{AddressPrivate}},
+	}}
+
+	tests := []struct {
+		name   string
+		url    string
+		host   string
+		port   int
+		want   EndpointMetadata
+		wantOk bool
+	}{
+		{
+			name:   "required endpoint",
+			url:    "https://quay.io:443",
+			host:   "quay.io",
+			port:   443,
+			want:   EndpointMetadata{Required: true, Protocol: ProtocolHTTPS},
+			wantOk: true,
+		},
+		{
+			name:   "optional endpoint",
+			host:   "console.redhat.com",
+			port:   80,
+			want:   EndpointMetadata{Protocol: ProtocolHTTP, Category: "telemetry"},
+			wantOk: true,
+		},
+		{
+			name:   "matched by url",
+			url:    "https://api.openshift.com:443/healthz",
+			host:   "api.openshift.com",
+			port:   443,
+			want:   EndpointMetadata{Required: true, Protocol: ProtocolHTTPS, ExpectedStatus: []int{200, 204}},
+			wantOk: true,
+		},
+		{
+			name:   "tls endpoint",
+			url:    "https://mirror.example.com:8443",
+			host:   "mirror.example.com",
+			port:   8443,
+			want:   EndpointMetadata{Required: true, Protocol: ProtocolTLS},
+			wantOk: true,
+		},
+		{
+			name:   "wildcard sample",
+			url:    "https://oauth.apps.example.com:443",
+			host:   "oauth.apps.example.com",
+			port:   443,
+			want:   EndpointMetadata{Required: true, Wildcard: "*.apps.example.com", AnyOf: "apps", Protocol: ProtocolHTTPS},
+			wantOk: true,
+		},
+		{
+			name:   "expected addresses",
+			host:   "sts.us-east-1.amazonaws.com",
+			port:   443,
+			want:   EndpointMetadata{Required: true, Protocol: ProtocolHTTPS, ExpectedAddresses: []string{AddressPrivate}},
+			wantOk: true,
+		},
+		{
+			name: "unlisted port",
+			host: "console.redhat.com",
+			port: 8443,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := egressList.Annotate(tt.url, tt.host, tt.port)
+			if ok != tt.wantOk {
+				t.Fatalf("got ok %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEndpointMetadata_ExpectsAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+		remoteIP string
+		want     bool
+	}{
+		{name: "no expected addresses", remoteIP: "54.240.250.235", want: true},
+		{name: "private address", expected: []string{AddressPrivate}, remoteIP: "10.0.1.2", want: true},
+		{name: "public address", expected: []string{AddressPrivate}, remoteIP: "54.240.250.235", want: false},
+		{name: "within cidr", expected: []string{"10.0.0.0/16"}, remoteIP: "10.0.3.4", want: true},
+		{name: "public address expected", expected: []string{AddressPublic}, remoteIP: "54.240.250.235", want: true},
+		{name: "loopback address isn't public", expected: []string{AddressPublic}, remoteIP: "127.0.0.1", want: false},
+		{name: "link-local address isn't public", expected: []string{AddressPublic}, remoteIP: "169.254.169.254", want: false},
+		{name: "unspecified address isn't public", expected: []string{AddressPublic}, remoteIP: "::", want: false},
+		{name: "unparsable address", expected: []string{AddressPublic}, remoteIP: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (EndpointMetadata{ExpectedAddresses: tt.expected}).ExpectsAddress(tt.remoteIP); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbeddedEgressListsParse(t *testing.T) {
+	for _, platform := range []cloud.Platform{cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress, cloud.GCPClassic} {
+		t.Run(platform.String(), func(t *testing.T) {
+			egressListYaml, err := GetLocalEgressList(platform)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			egressList, err := ParseEgressList(egressListYaml, map[string]string{"AWS_REGION": "us-east-1"})
+			if err != nil {
+				t.Fatalf("failed to parse embedded egress list: %v", err)
+			}
+			if len(egressList.Endpoints) == 0 {
+				t.Errorf("expected embedded egress list to contain endpoints")
+			}
+		})
+	}
+}
+
+func TestEgressList_WithDefaultExpectedAddresses(t *testing.T) {
+	egressList := &EgressList{Endpoints: []Endpoint{
+		{Host: "sts.us-east-1.amazonaws.com", Ports: []int{443}},
+		{Host: "quay.io", Ports: []int{443}, ExpectedAddresses: []string{AddressPublic}},
+	}}
+
+	got := egressList.WithDefaultExpectedAddresses(AddressPrivate)
+	want := []Endpoint{
+		{Host: "sts.us-east-1.amazonaws.com", Ports: []int{443}, ExpectedAddresses: []string{AddressPrivate}},
+		{Host: "quay.io", Ports: []int{443}, ExpectedAddresses: []string{AddressPublic}},
+	}
+	if !reflect.DeepEqual(got.Endpoints, want) {
+		t.Errorf("got %+v, want %+v", got.Endpoints, want)
+	}
+	if egressList.Endpoints[0].ExpectedAddresses != nil {
+		t.Errorf("expected the original list to be left unchanged, got %+v", egressList.Endpoints[0])
+	}
+}
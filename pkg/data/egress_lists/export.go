@@ -0,0 +1,371 @@
+package egress_lists
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats supported by Export
+const (
+	ExportFormatYAML  = "yaml"
+	ExportFormatJSON  = "json"
+	ExportFormatCSV   = "csv"
+	ExportFormatSquid = "squid"
+	// ExportFormatAWSNetworkFirewall is an AWS Network Firewall stateful rule group holding a domain
+	// list, e.g., for "aws network-firewall create-rule-group --rule-group file://..."
+	ExportFormatAWSNetworkFirewall = "aws-network-firewall"
+	ExportFormatSuricata           = "suricata"
+	// ExportFormatGCPFirewall is a list of GCP network firewall policy rules matching destination
+	// FQDNs
+	ExportFormatGCPFirewall = "gcp-firewall"
+)
+
+// ExportFormats lists every format supported by Export
+var ExportFormats = []string{
+	ExportFormatYAML,
+	ExportFormatJSON,
+	ExportFormatCSV,
+	ExportFormatSquid,
+	ExportFormatAWSNetworkFirewall,
+	ExportFormatSuricata,
+	ExportFormatGCPFirewall,
+}
+
+// suricataFirstSID is the signature ID of the first rule exported to Suricata. Rules are numbered
+// from the start of the range reserved for local rules
+const suricataFirstSID = 1000001
+
+// gcpFirstPriority is the priority of the first rule exported to GCP firewall policies. Each
+// following rule has the next priority
+const gcpFirstPriority = 1000
+
+// ExportedEndpoint is a single host and port of an egress list, as exported to the json and csv
+// formats
+type ExportedEndpoint struct {
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Protocol    string `json:"protocol"`
+	Required    bool   `json:"required"`
+	Category    string `json:"category,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Export writes l to w in format, one of ExportFormats, for configuring firewalls and proxies to
+// allow the list's endpoints. Wildcard hosts are exported as wildcards rather than as their samples.
+// It returns the "host:port" of every endpoint that format can't express (e.g., plain TCP endpoints
+// identified by name, which firewalls matching TLS SNI or HTTP Host headers can't allow), which
+// are left out of the export
+func Export(w io.Writer, l *EgressList, format string) ([]string, error) {
+	switch format {
+	case ExportFormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		return nil, encoder.Encode(l)
+	case ExportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return nil, encoder.Encode(l.exportedEndpoints())
+	case ExportFormatCSV:
+		return nil, exportCSV(w, l)
+	case ExportFormatSquid:
+		return nil, exportSquid(w, l)
+	case ExportFormatAWSNetworkFirewall:
+		return exportAWSNetworkFirewall(w, l)
+	case ExportFormatSuricata:
+		return exportSuricata(w, l)
+	case ExportFormatGCPFirewall:
+		return exportGCPFirewall(w, l)
+	default:
+		return nil, fmt.Errorf("unknown export format '%s', must be one of %s", format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// exportedEndpoints flattens l into one ExportedEndpoint per host and port
+func (l *EgressList) exportedEndpoints() []ExportedEndpoint {
+	exported := []ExportedEndpoint{}
+	for _, endpoint := range l.Endpoints {
+		for _, port := range endpoint.Ports {
+			exported = append(exported, ExportedEndpoint{
+				Host:        endpoint.Host,
+				Port:        port,
+				Protocol:    endpoint.ProtocolFor(port),
+				Required:    endpoint.IsRequired(),
+				Category:    endpoint.Category,
+				Description: endpoint.Description,
+			})
+		}
+	}
+	return exported
+}
+
+// domains returns the distinct hosts of l's endpoints for which include returns true, in order,
+// leaving out hosts already covered by one of the included wildcards
+func (l *EgressList) domains(include func(endpoint Endpoint, port int) bool) []string {
+	included := func(endpoint Endpoint) bool {
+		return slices.ContainsFunc(endpoint.Ports, func(port int) bool { return include(endpoint, port) })
+	}
+	var wildcards, domains []string
+	for _, endpoint := range l.Endpoints {
+		if endpoint.IsWildcard() && included(endpoint) {
+			wildcards = append(wildcards, strings.TrimPrefix(endpoint.Host, "*"))
+		}
+	}
+	for _, endpoint := range l.Endpoints {
+		if !included(endpoint) {
+			continue
+		}
+		coveredByWildcard := !endpoint.IsWildcard() && slices.ContainsFunc(wildcards, func(suffix string) bool {
+			return strings.HasSuffix(endpoint.Host, suffix)
+		})
+		if coveredByWildcard || slices.Contains(domains, endpoint.Host) {
+			continue
+		}
+		domains = append(domains, endpoint.Host)
+	}
+	return domains
+}
+
+func exportCSV(w io.Writer, l *EgressList) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"host", "port", "protocol", "required", "category", "description"}); err != nil {
+		return err
+	}
+	for _, e := range l.exportedEndpoints() {
+		record := []string{e.Host, strconv.Itoa(e.Port), e.Protocol, strconv.FormatBool(e.Required), e.Category, e.Description}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// exportSquid writes squid.conf directives allowing each of the list's hosts (as "dstdomain", or
+// "dst" for IP addresses) on its own ports only. Hosts are grouped by their set of ports, with one
+// ACL pair and "http_access" rule per set. Wildcards become Squid's ".example.com" subdomain syntax
+func exportSquid(w io.Writer, l *EgressList) error {
+	type squidHost struct {
+		name  string
+		ports []int
+	}
+	var hosts []*squidHost
+	var wildcards []*squidHost
+	byName := map[string]*squidHost{}
+	for _, endpoint := range l.Endpoints {
+		name := strings.TrimPrefix(endpoint.Host, "*")
+		host, ok := byName[name]
+		if !ok {
+			host = &squidHost{name: name}
+			byName[name] = host
+			hosts = append(hosts, host)
+			if endpoint.IsWildcard() {
+				wildcards = append(wildcards, host)
+			}
+		}
+		for _, port := range endpoint.Ports {
+			if !slices.Contains(host.ports, port) {
+				host.ports = append(host.ports, port)
+			}
+		}
+	}
+
+	// Group hosts by their ports, leaving out the ports a wildcard already allows for its subdomains
+	type squidPortGroup struct {
+		ports        []string
+		domains, ips []string
+	}
+	var groups []*squidPortGroup
+	groupsByPorts := map[string]*squidPortGroup{}
+	for _, host := range hosts {
+		ports := slices.Clone(host.ports)
+		if !slices.Contains(wildcards, host) {
+			for _, wildcard := range wildcards {
+				if strings.HasSuffix(host.name, wildcard.name) {
+					ports = slices.DeleteFunc(ports, func(port int) bool { return slices.Contains(wildcard.ports, port) })
+				}
+			}
+		}
+		if len(ports) == 0 {
+			continue
+		}
+		slices.Sort(ports)
+		portStrs := make([]string, len(ports))
+		for i, port := range ports {
+			portStrs[i] = strconv.Itoa(port)
+		}
+		key := strings.Join(portStrs, "_")
+		group, ok := groupsByPorts[key]
+		if !ok {
+			group = &squidPortGroup{ports: portStrs}
+			groupsByPorts[key] = group
+			groups = append(groups, group)
+		}
+		if net.ParseIP(host.name) != nil {
+			group.ips = append(group.ips, host.name)
+		} else {
+			group.domains = append(group.domains, host.name)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Generated by osd-network-verifier egress-list export\n")
+	for _, group := range groups {
+		suffix := strings.Join(group.ports, "_")
+		fmt.Fprintf(&b, "acl osd_egress_ports_%s port %s\n", suffix, strings.Join(group.ports, " "))
+		for _, domain := range group.domains {
+			fmt.Fprintf(&b, "acl osd_egress_domains_%s dstdomain %s\n", suffix, domain)
+		}
+		for _, ip := range group.ips {
+			fmt.Fprintf(&b, "acl osd_egress_ips_%s dst %s\n", suffix, ip)
+		}
+		if len(group.domains) > 0 {
+			fmt.Fprintf(&b, "http_access allow osd_egress_domains_%[1]s osd_egress_ports_%[1]s\n", suffix)
+		}
+		if len(group.ips) > 0 {
+			fmt.Fprintf(&b, "http_access allow osd_egress_ips_%[1]s osd_egress_ports_%[1]s\n", suffix)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// awsRuleGroup is the subset of the AWS Network Firewall RuleGroup structure used for domain lists
+type awsRuleGroup struct {
+	RulesSource struct {
+		RulesSourceList struct {
+			Targets            []string `json:"Targets"`
+			TargetTypes        []string `json:"TargetTypes"`
+			GeneratedRulesType string   `json:"GeneratedRulesType"`
+		} `json:"RulesSourceList"`
+	} `json:"RulesSource"`
+}
+
+// exportAWSNetworkFirewall writes a stateful rule group allowlisting the TLS SNI and HTTP Host of
+// the list's http(s) and tls endpoints. Domain lists match names only, so other endpoints are
+// skipped, as are IP addresses
+func exportAWSNetworkFirewall(w io.Writer, l *EgressList) ([]string, error) {
+	var skipped []string
+	targets := []string{}
+	for _, host := range l.domains(func(endpoint Endpoint, port int) bool { return endpoint.ProtocolFor(port) != ProtocolTCP }) {
+		if net.ParseIP(host) != nil {
+			continue
+		}
+		// Domain lists match subdomains of targets starting with a dot
+		targets = append(targets, strings.TrimPrefix(host, "*"))
+	}
+	for _, endpoint := range l.Endpoints {
+		for _, port := range endpoint.Ports {
+			if endpoint.ProtocolFor(port) == ProtocolTCP || net.ParseIP(endpoint.Host) != nil {
+				skipped = append(skipped, net.JoinHostPort(endpoint.Host, strconv.Itoa(port)))
+			}
+		}
+	}
+
+	var group awsRuleGroup
+	group.RulesSource.RulesSourceList.Targets = targets
+	group.RulesSource.RulesSourceList.TargetTypes = []string{"TLS_SNI", "HTTP_HOST"}
+	group.RulesSource.RulesSourceList.GeneratedRulesType = "ALLOWLIST"
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return skipped, encoder.Encode(group)
+}
+
+// exportSuricata writes a Suricata "pass" rule for each host and port: tls rules matching the SNI
+// of https and tls endpoints, http rules matching the Host header of http endpoints, and tcp rules
+// for IP addresses. Plain TCP endpoints identified by name can't be matched, so they're skipped
+func exportSuricata(w io.Writer, l *EgressList) ([]string, error) {
+	var skipped []string
+	var b strings.Builder
+	b.WriteString("# Generated by osd-network-verifier egress-list export\n")
+	sid := suricataFirstSID
+	for _, endpoint := range l.Endpoints {
+		// Wildcards match any subdomain, other hosts must match exactly
+		match := fmt.Sprintf(`content:"%s"; startswith; endswith; nocase;`, endpoint.Host)
+		if endpoint.IsWildcard() {
+			match = fmt.Sprintf(`content:"%s"; endswith; nocase;`, strings.TrimPrefix(endpoint.Host, "*"))
+		}
+		for _, port := range endpoint.Ports {
+			msg := fmt.Sprintf("osd-network-verifier allow %s", net.JoinHostPort(endpoint.Host, strconv.Itoa(port)))
+			var rule string
+			switch {
+			case net.ParseIP(endpoint.Host) != nil:
+				rule = fmt.Sprintf(`pass tcp $HOME_NET any -> %s %d (msg:"%s"; flow:to_server;`, endpoint.Host, port, msg)
+			case endpoint.ProtocolFor(port) == ProtocolHTTP:
+				rule = fmt.Sprintf(`pass http $HOME_NET any -> $EXTERNAL_NET %d (msg:"%s"; http.host; %s`, port, msg, match)
+			case endpoint.ProtocolFor(port) == ProtocolTCP:
+				skipped = append(skipped, net.JoinHostPort(endpoint.Host, strconv.Itoa(port)))
+				continue
+			default:
+				rule = fmt.Sprintf(`pass tls $HOME_NET any -> $EXTERNAL_NET %d (msg:"%s"; tls.sni; %s`, port, msg, match)
+			}
+			fmt.Fprintf(&b, "%s sid:%d; rev:1;)\n", rule, sid)
+			sid++
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return skipped, err
+}
+
+// gcpFirewallRule is the subset of a GCP network firewall policy rule (FirewallPolicyRule) used to
+// allow egress
+type gcpFirewallRule struct {
+	Priority    int    `json:"priority"`
+	Description string `json:"description,omitempty"`
+	Direction   string `json:"direction"`
+	Action      string `json:"action"`
+	Match       struct {
+		DestFqdns     []string          `json:"destFqdns,omitempty"`
+		DestIPRanges  []string          `json:"destIpRanges,omitempty"`
+		Layer4Configs []gcpLayer4Config `json:"layer4Configs"`
+	} `json:"match"`
+}
+
+type gcpLayer4Config struct {
+	IPProtocol string   `json:"ipProtocol"`
+	Ports      []string `json:"ports"`
+}
+
+// exportGCPFirewall writes an egress allow rule for each endpoint, matching its FQDN (or IP address)
+// and ports. FQDN objects don't support wildcards, so wildcard endpoints are skipped
+func exportGCPFirewall(w io.Writer, l *EgressList) ([]string, error) {
+	var skipped []string
+	rules := []gcpFirewallRule{}
+	for _, endpoint := range l.Endpoints {
+		if endpoint.IsWildcard() {
+			for _, port := range endpoint.Ports {
+				skipped = append(skipped, net.JoinHostPort(endpoint.Host, strconv.Itoa(port)))
+			}
+			continue
+		}
+		rule := gcpFirewallRule{
+			Priority:    gcpFirstPriority + len(rules),
+			Description: endpoint.Description,
+			Direction:   "EGRESS",
+			Action:      "allow",
+		}
+		if ip := net.ParseIP(endpoint.Host); ip == nil {
+			rule.Match.DestFqdns = []string{endpoint.Host}
+		} else if ip.To4() != nil {
+			rule.Match.DestIPRanges = []string{endpoint.Host + "/32"}
+		} else {
+			rule.Match.DestIPRanges = []string{endpoint.Host + "/128"}
+		}
+		layer4Config := gcpLayer4Config{IPProtocol: "tcp"}
+		for _, port := range endpoint.Ports {
+			layer4Config.Ports = append(layer4Config.Ports, strconv.Itoa(port))
+		}
+		rule.Match.Layer4Configs = []gcpLayer4Config{layer4Config}
+		rules = append(rules, rule)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return skipped, encoder.Encode(rules)
+}
@@ -0,0 +1,170 @@
+package egress_lists
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	optional := false
+	egressList := &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+		{Host: "quay.io", Ports: []int{80, 443}, Category: "registry", Description: "Images, \"mostly\""},
+		{Host: "cdn.s3.amazonaws.com", Ports: []int{443}},
+		{Host: "*.s3.amazonaws.com", Ports: []int{443}, Samples: []string{"cdn"}},
+		{Host: "splunk.example.com", Ports: []int{9997}, Required: &optional},
+		{Host: "mirror.example.com", Ports: []int{8443}, Protocol: ProtocolTLS},
+		{Host: "10.0.0.1", Ports: []int{443}},
+	}}
+
+	tests := []struct {
+		format      string
+		want        []string
+		dontWant    []string
+		wantSkipped []string
+	}{
+		{
+			format: ExportFormatYAML,
+			want:   []string{"version: v2\n", "  - host: '*.s3.amazonaws.com'\n    ports:\n      - 443\n    samples:\n      - cdn\n"},
+		},
+		{
+			format: ExportFormatJSON,
+			want: []string{
+				`{
+    "host": "quay.io",
+    "port": 80,
+    "protocol": "http",
+    "required": true,
+    "category": "registry",`,
+				`"host": "splunk.example.com",
+    "port": 9997,
+    "protocol": "tcp",
+    "required": false`,
+			},
+		},
+		{
+			format: ExportFormatCSV,
+			want: []string{
+				"host,port,protocol,required,category,description\n",
+				"quay.io,443,https,true,registry,\"Images, \"\"mostly\"\"\"\n",
+				"mirror.example.com,8443,tls,true,,\n",
+			},
+		},
+		{
+			format: ExportFormatSquid,
+			want: []string{
+				"acl osd_egress_ports_80_443 port 80 443\nacl osd_egress_domains_80_443 dstdomain quay.io\nhttp_access allow osd_egress_domains_80_443 osd_egress_ports_80_443\n",
+				"acl osd_egress_ports_443 port 443\nacl osd_egress_domains_443 dstdomain .s3.amazonaws.com\nacl osd_egress_ips_443 dst 10.0.0.1\n" +
+					"http_access allow osd_egress_domains_443 osd_egress_ports_443\nhttp_access allow osd_egress_ips_443 osd_egress_ports_443\n",
+				"acl osd_egress_ports_9997 port 9997\nacl osd_egress_domains_9997 dstdomain splunk.example.com\n",
+				"acl osd_egress_ports_8443 port 8443\nacl osd_egress_domains_8443 dstdomain mirror.example.com\n",
+			},
+			// Covered by the wildcard, and no host is allowed on another host's ports
+			dontWant: []string{"cdn.s3.amazonaws.com", "port 80 443 9997"},
+		},
+		{
+			format: ExportFormatAWSNetworkFirewall,
+			want: []string{`"Targets": [
+        "quay.io",
+        ".s3.amazonaws.com",
+        "mirror.example.com"
+      ]`, `"GeneratedRulesType": "ALLOWLIST"`},
+			wantSkipped: []string{"splunk.example.com:9997", "10.0.0.1:443"},
+		},
+		{
+			format: ExportFormatSuricata,
+			want: []string{
+				`pass http $HOME_NET any -> $EXTERNAL_NET 80 (msg:"osd-network-verifier allow quay.io:80"; http.host; content:"quay.io"; startswith; endswith; nocase; sid:1000001; rev:1;)`,
+				`pass tls $HOME_NET any -> $EXTERNAL_NET 443 (msg:"osd-network-verifier allow *.s3.amazonaws.com:443"; tls.sni; content:".s3.amazonaws.com"; endswith; nocase; sid:1000004; rev:1;)`,
+				`pass tls $HOME_NET any -> $EXTERNAL_NET 8443 (msg:"osd-network-verifier allow mirror.example.com:8443"; tls.sni; content:"mirror.example.com"; startswith; endswith; nocase; sid:1000005; rev:1;)`,
+				`pass tcp $HOME_NET any -> 10.0.0.1 443 (msg:"osd-network-verifier allow 10.0.0.1:443"; flow:to_server; sid:1000006; rev:1;)`,
+			},
+			wantSkipped: []string{"splunk.example.com:9997"},
+		},
+		{
+			format: ExportFormatGCPFirewall,
+			want: []string{
+				`"priority": 1000,
+    "description": "Images, \"mostly\"",
+    "direction": "EGRESS",
+    "action": "allow",
+    "match": {
+      "destFqdns": [
+        "quay.io"
+      ],
+      "layer4Configs": [
+        {
+          "ipProtocol": "tcp",
+          "ports": [
+            "80",
+            "443"
+          ]`,
+				`"destIpRanges": [
+        "10.0.0.1/32"
+      ]`,
+			},
+			wantSkipped: []string{"*.s3.amazonaws.com:443"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			skipped, err := Export(&buf, egressList, tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected export to contain %q, got:\n%s", want, got)
+				}
+			}
+			for _, dontWant := range tt.dontWant {
+				if strings.Contains(got, dontWant) {
+					t.Errorf("expected export not to contain %q, got:\n%s", dontWant, got)
+				}
+			}
+			if !reflect.DeepEqual(skipped, tt.wantSkipped) {
+				t.Errorf("expected skipped endpoints %v, got %v", tt.wantSkipped, skipped)
+			}
+		})
+	}
+
+	if _, err := Export(&bytes.Buffer{}, egressList, "iptables"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestExport_SquidWildcardPorts(t *testing.T) {
+	egressList := &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+		{Host: "*.example.com", Ports: []int{443}, Samples: []string{"www"}},
+		{Host: "api.example.com", Ports: []int{443, 6443}},
+	}}
+	var b bytes.Buffer
+	if _, err := Export(&b, egressList, ExportFormatSquid); err != nil {
+		t.Fatal(err)
+	}
+	// The wildcard only allows the subdomain on 443, so it must still be allowed on 6443
+	want := "acl osd_egress_ports_443 port 443\nacl osd_egress_domains_443 dstdomain .example.com\nhttp_access allow osd_egress_domains_443 osd_egress_ports_443\n" +
+		"acl osd_egress_ports_6443 port 6443\nacl osd_egress_domains_6443 dstdomain api.example.com\nhttp_access allow osd_egress_domains_6443 osd_egress_ports_6443\n"
+	if got := b.String(); !strings.HasSuffix(got, want) {
+		t.Errorf("got %q, want it to end with %q", got, want)
+	}
+}
+
+func TestExport_AWSNetworkFirewallTCPWildcard(t *testing.T) {
+	egressList := &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+		{Host: "*.example.com", Ports: []int{9997}, Samples: []string{"inputs"}},
+		{Host: "api.example.com", Ports: []int{443}},
+	}}
+	var b bytes.Buffer
+	if _, err := Export(&b, egressList, ExportFormatAWSNetworkFirewall); err != nil {
+		t.Fatal(err)
+	}
+	// The tcp wildcard isn't exported, so it mustn't hide the https subdomain
+	if want := "\"Targets\": [\n        \"api.example.com\"\n      ]"; !strings.Contains(b.String(), want) {
+		t.Errorf("got %q, want it to contain %q", b.String(), want)
+	}
+}
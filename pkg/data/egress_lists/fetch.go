@@ -0,0 +1,320 @@
+package egress_lists
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+)
+
+const (
+	githubOwner = "openshift"
+	githubRepo  = "osd-network-verifier"
+
+	// DefaultFetchTimeout bounds requests to GitHub when FetchOptions.Timeout is unset
+	DefaultFetchTimeout = 10 * time.Second
+
+	// SourceEmbedded is the FetchResult.Source of the egress lists embedded in the binary
+	SourceEmbedded = "embedded"
+)
+
+var (
+	// commitSHAPattern matches full git commit SHAs, whose content never changes
+	commitSHAPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	// cacheKeyReplacer makes git refs safe to use in cache file names
+	cacheKeyReplacer = strings.NewReplacer("/", "_", "\\", "_", ":", "_")
+	// githubBaseURL overrides the GitHub API URL in tests
+	githubBaseURL *url.URL
+)
+
+// FetchOptions controls how FetchEgressList gets a platform's egress list from GitHub
+type FetchOptions struct {
+	// Ref is the branch, tag or commit SHA of the list to fetch. Defaults to the repository's default
+	// branch. When set, FetchEgressList fails rather than falling back to the embedded list
+	Ref string
+	// CacheDir, if set, is a directory in which fetched lists are cached. Cached lists are
+	// revalidated using their ETag, and used if GitHub can't be reached. Lists pinned to a commit
+	// SHA are never refetched once cached
+	CacheDir string
+	// Offline skips GitHub (and the cache) entirely, using only the lists embedded in the binary
+	Offline bool
+	// Token authenticates requests to GitHub, e.g., to avoid the rate limits of anonymous requests
+	Token string
+	// Timeout bounds requests to GitHub. Defaults to DefaultFetchTimeout
+	Timeout time.Duration
+	// Signatures controls the verification of lists' detached signatures, which are fetched from
+	// the list's path with SignatureSuffix appended. Lists aren't verified if no keys are trusted
+	Signatures SignatureOptions
+}
+
+// FetchResult is an egress list fetched by FetchEgressList, and where it came from
+type FetchResult struct {
+	Yaml string
+	// Source is the GitHub URL of the list, or SourceEmbedded
+	Source string
+	// SHA is the git blob SHA of the list when fetched from GitHub
+	SHA string
+	// Ref is the git ref the list was fetched at. Empty for the default branch
+	Ref string
+	// Cached is true if the list was read from FetchOptions.CacheDir
+	Cached bool
+	// Unverified is true if the list came from GitHub (or the cache) without its signature being
+	// verified, because verification was skipped
+	Unverified bool
+	// FallbackReason explains why a cached or embedded list was used instead of fetching the list
+	// from GitHub. Empty if the list was fetched (or revalidated) successfully or Offline was set
+	FallbackReason string
+}
+
+// Description describes where the list came from, e.g., for logging
+func (r *FetchResult) Description() string {
+	if r.Source == SourceEmbedded {
+		return "embedded egress list"
+	}
+	description := fmt.Sprintf("egress list from %s at SHA %s", r.Source, r.SHA)
+	if r.Ref != "" {
+		description += fmt.Sprintf(" (ref %s)", r.Ref)
+	}
+	if r.Cached {
+		description += " (cached)"
+	}
+	return description
+}
+
+// cacheEntry is the on-disk format of a cached egress list
+type cacheEntry struct {
+	ETag      string `json:"etag"`
+	SHA       string `json:"sha"`
+	URL       string `json:"url"`
+	Content   string `json:"content"`
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// FetchEgressList gets platformType's egress list from GitHub as specified by opts. Unless a ref was
+// pinned, it falls back to the latest cached copy or the list embedded in the binary if GitHub can't
+// be reached or the list's signature can't be verified (including when no keys are trusted), and
+// records why in FetchResult.FallbackReason
+func FetchEgressList(ctx context.Context, platformType cloud.Platform, opts FetchOptions) (*FetchResult, error) {
+	if opts.Offline {
+		return EmbeddedEgressList(platformType, "")
+	}
+	verify := opts.Signatures.required()
+	// Without trusted keys, neither the list from GitHub nor a cached copy could be verified
+	if verify && len(opts.Signatures.TrustedKeys) == 0 {
+		if opts.Ref != "" {
+			return nil, fmt.Errorf("failed to get egress list from GitHub at ref %s: %w", opts.Ref, ErrNoTrustedKeys)
+		}
+		return EmbeddedEgressList(platformType, fmt.Sprintf("can't verify egress lists from GitHub, using embedded list: %v", ErrNoTrustedKeys))
+	}
+
+	path, err := githubEgressListPath(platformType)
+	if err != nil {
+		return nil, err
+	}
+
+	cachePath := ""
+	var cached *cacheEntry
+	if opts.CacheDir != "" {
+		cacheKey := platformType.String()
+		if opts.Ref != "" {
+			cacheKey += "@" + cacheKeyReplacer.Replace(opts.Ref)
+		}
+		cachePath = filepath.Join(opts.CacheDir, cacheKey+".json")
+		cached = readCacheEntry(cachePath)
+		// The cache may have been tampered with, or written before a key stopped being trusted
+		if cached != nil && verify && opts.Signatures.Verify([]byte(cached.Content), cached.Signature) != nil {
+			cached = nil
+		}
+	}
+	cachedResult := func(fallbackReason string) *FetchResult {
+		return &FetchResult{Yaml: cached.Content, Source: cached.URL, SHA: cached.SHA, Ref: opts.Ref, Cached: true, Unverified: !verify, FallbackReason: fallbackReason}
+	}
+
+	// The content of a commit never changes, so there's no need to revalidate it
+	if cached != nil && commitSHAPattern.MatchString(opts.Ref) {
+		return cachedResult(""), nil
+	}
+
+	entry, notModified, err := fetchGithubEntry(ctx, path, cached, opts)
+	switch {
+	case err == nil && notModified:
+		return cachedResult(""), nil
+	case err == nil:
+		if cachePath != "" {
+			// Caching is best-effort: failing to write the cache mustn't fail the run
+			_ = writeCacheEntry(cachePath, entry)
+		}
+		return &FetchResult{Yaml: entry.Content, Source: entry.URL, SHA: entry.SHA, Ref: opts.Ref, Unverified: !verify}, nil
+	case cached != nil:
+		return cachedResult(fmt.Sprintf("failed to get egress list from GitHub, using cached copy: %v", err)), nil
+	case opts.Ref != "":
+		return nil, fmt.Errorf("failed to get egress list from GitHub at ref %s: %w", opts.Ref, err)
+	default:
+		return EmbeddedEgressList(platformType, fmt.Sprintf("failed to get egress list from GitHub, using embedded list: %v", err))
+	}
+}
+
+// Parse parses the fetched list using variables (see ParseEgressList). Unless a ref was pinned, a
+// list from GitHub that can't be parsed is replaced with platformType's embedded list, updating r
+func (r *FetchResult) Parse(platformType cloud.Platform, variables map[string]string) (*EgressList, error) {
+	egressList, err := ParseEgressList(r.Yaml, variables)
+	if err == nil || r.Source == SourceEmbedded || r.Ref != "" {
+		return egressList, err
+	}
+
+	embedded, embeddedErr := EmbeddedEgressList(platformType, fmt.Sprintf("failed to parse egress list from %s, using embedded list: %v", r.Source, err))
+	if embeddedErr != nil {
+		return nil, embeddedErr
+	}
+	*r = *embedded
+	return ParseEgressList(r.Yaml, variables)
+}
+
+// EmbeddedEgressList returns platformType's egress list embedded in the binary, recording
+// fallbackReason as the reason it was used instead of fetching the list from GitHub (if any)
+func EmbeddedEgressList(platformType cloud.Platform, fallbackReason string) (*FetchResult, error) {
+	yamlStr, err := GetLocalEgressList(platformType)
+	if err != nil {
+		return nil, err
+	}
+	return &FetchResult{Yaml: yamlStr, Source: SourceEmbedded, FallbackReason: fallbackReason}, nil
+}
+
+// fetchGithubEntry gets the list at path and its signature from GitHub, verifying the signature
+// unless opts doesn't require it. notModified is true if the cached copy is still current
+func fetchGithubEntry(ctx context.Context, path string, cached *cacheEntry, opts FetchOptions) (entry *cacheEntry, notModified bool, err error) {
+	content, etag, notModified, err := fetchGithubContent(ctx, path, cached, opts)
+	if err != nil || notModified {
+		return nil, notModified, err
+	}
+	yamlStr, err := content.GetContent()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode egress list from %s: %w", content.GetURL(), err)
+	}
+	entry = &cacheEntry{ETag: etag, SHA: content.GetSHA(), URL: content.GetURL(), Content: yamlStr}
+	if !opts.Signatures.required() {
+		return entry, false, nil
+	}
+
+	sigContent, _, _, err := fetchGithubContent(ctx, path+SignatureSuffix, nil, opts)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusNotFound {
+		return nil, false, fmt.Errorf("%w: no signature found at %s", ErrUnsigned, path+SignatureSuffix)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get egress list signature: %w", err)
+	}
+	sigStr, err := sigContent.GetContent()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode egress list signature from %s: %w", sigContent.GetURL(), err)
+	}
+	entry.Signature, err = DecodeSignature([]byte(sigStr))
+	if err != nil {
+		return nil, false, err
+	}
+	if err := opts.Signatures.Verify([]byte(entry.Content), entry.Signature); err != nil {
+		return nil, false, fmt.Errorf("refusing egress list from %s: %w", entry.URL, err)
+	}
+	return entry, false, nil
+}
+
+// fetchGithubContent gets the file at path from GitHub, conditionally on it having changed since it
+// was cached. notModified is true if the cached copy is still current
+func fetchGithubContent(ctx context.Context, path string, cached *cacheEntry, opts FetchOptions) (content *github.RepositoryContent, etag string, notModified bool, err error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultFetchTimeout
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client := github.NewClient(&http.Client{Timeout: timeout})
+	if opts.Token != "" {
+		client = client.WithAuthToken(opts.Token)
+	}
+	if githubBaseURL != nil {
+		client.BaseURL = githubBaseURL
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", githubOwner, githubRepo, path)
+	if opts.Ref != "" {
+		u += "?ref=" + url.QueryEscape(opts.Ref)
+	}
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if cached != nil && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	content = &github.RepositoryContent{}
+	resp, err := client.Do(ctx, req, content)
+	if resp != nil && resp.StatusCode == http.StatusNotModified && cached != nil {
+		return nil, "", true, nil
+	}
+	if err != nil {
+		return nil, "", false, err
+	}
+	return content, resp.Header.Get("ETag"), false, nil
+}
+
+// githubEgressListPath returns the path of platformType's egress list within the repository
+func githubEgressListPath(platformType cloud.Platform) (string, error) {
+	switch platformType {
+	case cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress, cloud.GCPClassic:
+		return fmt.Sprintf("pkg/data/egress_lists/%s.yaml", platformType), nil
+	default:
+		return "", fmt.Errorf("no egress list registered for platform '%s'", platformType)
+	}
+}
+
+// readCacheEntry returns the entry cached at path, or nil if there's no valid entry
+func readCacheEntry(path string) *cacheEntry {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	entry := &cacheEntry{}
+	if err := json.Unmarshal(b, entry); err != nil || entry.Content == "" {
+		return nil
+	}
+	return entry
+}
+
+// writeCacheEntry atomically replaces the entry cached at path
+func writeCacheEntry(path string, entry *cacheEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,315 @@
+package egress_lists
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+)
+
+const testFetchedYaml = "endpoints:\n  - host: fetched.example.com\n    ports: [443]\n"
+
+// testSigningKey signs the egress lists served by fakeGithub
+var testSigningKey = ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+
+// fakeGithub serves egress lists and their signatures like the GitHub contents API, recording the
+// requests it receives
+type fakeGithub struct {
+	status int
+	etag   string
+	// signingKey signs the served lists. Defaults to testSigningKey
+	signingKey ed25519.PrivateKey
+	unsigned   bool
+	requests   []*http.Request
+}
+
+func (f *fakeGithub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests = append(f.requests, r)
+	if f.status != 0 && f.status != http.StatusOK {
+		w.WriteHeader(f.status)
+		return
+	}
+
+	content := []byte(testFetchedYaml)
+	if strings.HasSuffix(r.URL.Path, SignatureSuffix) {
+		if f.unsigned {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		key := f.signingKey
+		if key == nil {
+			key = testSigningKey
+		}
+		content = []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, content)))
+	} else if f.etag != "" {
+		if r.Header.Get("If-None-Match") == f.etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", f.etag)
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"type":     "file",
+		"encoding": "base64",
+		"content":  base64.StdEncoding.EncodeToString(content),
+		"sha":      "fetched-sha",
+		"url":      "https://api.github.com" + r.URL.Path,
+	})
+}
+
+// withFakeGithub points FetchEgressList at a fake GitHub API for the duration of the test
+func withFakeGithub(t *testing.T, f *fakeGithub) {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := githubBaseURL
+	githubBaseURL = baseURL
+	t.Cleanup(func() { githubBaseURL = previous })
+}
+
+func TestFetchEgressList(t *testing.T) {
+	const commitSHA = "0123456789abcdef0123456789abcdef01234567"
+	const fetchedURL = "https://api.github.com/repos/openshift/osd-network-verifier/contents/pkg/data/egress_lists/aws-classic.yaml"
+	trusted := SignatureOptions{TrustedKeys: []ed25519.PublicKey{testSigningKey.Public().(ed25519.PublicKey)}}
+	cachedEntry := &cacheEntry{ETag: `"cached"`, SHA: "cached-sha", URL: "https://api.github.com/cached", Content: testFetchedYaml, Signature: ed25519.Sign(testSigningKey, []byte(testFetchedYaml))}
+	untrustedKey := ed25519.NewKeyFromSeed([]byte(strings.Repeat("u", ed25519.SeedSize)))
+
+	tests := []struct {
+		name   string
+		server fakeGithub
+		opts   FetchOptions
+		cached *cacheEntry
+		// untrusted leaves opts without trusted keys, which otherwise trust testSigningKey
+		untrusted    bool
+		wantSource   string
+		wantSHA      string
+		wantCached   bool
+		wantFallback bool
+		// wantUnverified expects the list's signature not to have been verified
+		wantUnverified bool
+		wantRequests   int
+		wantErr        bool
+	}{
+		{
+			name:         "offline",
+			opts:         FetchOptions{Offline: true},
+			cached:       cachedEntry,
+			wantSource:   SourceEmbedded,
+			wantRequests: 0,
+		},
+		{
+			name:         "fetched",
+			server:       fakeGithub{etag: `"fetched"`},
+			wantSource:   fetchedURL,
+			wantSHA:      "fetched-sha",
+			wantRequests: 2,
+		},
+		{
+			name:         "cache revalidated",
+			server:       fakeGithub{etag: `"cached"`},
+			cached:       cachedEntry,
+			wantSource:   cachedEntry.URL,
+			wantSHA:      cachedEntry.SHA,
+			wantCached:   true,
+			wantRequests: 1,
+		},
+		{
+			name:         "cache outdated",
+			server:       fakeGithub{etag: `"fetched"`},
+			cached:       cachedEntry,
+			wantSource:   fetchedURL,
+			wantSHA:      "fetched-sha",
+			wantRequests: 2,
+		},
+		{
+			name:         "server error falls back to cache",
+			server:       fakeGithub{status: http.StatusInternalServerError},
+			cached:       cachedEntry,
+			wantSource:   cachedEntry.URL,
+			wantSHA:      cachedEntry.SHA,
+			wantCached:   true,
+			wantFallback: true,
+			wantRequests: 1,
+		},
+		{
+			name:         "server error falls back to embedded list",
+			server:       fakeGithub{status: http.StatusInternalServerError},
+			wantSource:   SourceEmbedded,
+			wantFallback: true,
+			wantRequests: 1,
+		},
+		{
+			name:         "server error with pinned ref",
+			server:       fakeGithub{status: http.StatusNotFound},
+			opts:         FetchOptions{Ref: "v1.2.3"},
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "cached commit isn't refetched",
+			server:       fakeGithub{etag: `"fetched"`},
+			opts:         FetchOptions{Ref: commitSHA},
+			cached:       cachedEntry,
+			wantSource:   cachedEntry.URL,
+			wantSHA:      cachedEntry.SHA,
+			wantCached:   true,
+			wantRequests: 0,
+		},
+		{
+			name:         "no trusted keys falls back to embedded list",
+			server:       fakeGithub{etag: `"fetched"`, unsigned: true},
+			untrusted:    true,
+			wantSource:   SourceEmbedded,
+			wantFallback: true,
+			wantRequests: 0,
+		},
+		{
+			name:         "no trusted keys with pinned ref",
+			server:       fakeGithub{etag: `"fetched"`, unsigned: true},
+			opts:         FetchOptions{Ref: "v1.2.3"},
+			untrusted:    true,
+			wantRequests: 0,
+			wantErr:      true,
+		},
+		{
+			name:         "no trusted keys ignores unsigned cache",
+			server:       fakeGithub{etag: `"cached"`},
+			cached:       &cacheEntry{ETag: cachedEntry.ETag, SHA: cachedEntry.SHA, URL: cachedEntry.URL, Content: testFetchedYaml},
+			untrusted:    true,
+			wantSource:   SourceEmbedded,
+			wantFallback: true,
+			wantRequests: 0,
+		},
+		{
+			name:         "unsigned list falls back to embedded list",
+			server:       fakeGithub{unsigned: true},
+			wantSource:   SourceEmbedded,
+			wantFallback: true,
+			wantRequests: 2,
+		},
+		{
+			name:         "tampered list falls back to cache",
+			server:       fakeGithub{etag: `"fetched"`, signingKey: untrustedKey},
+			cached:       cachedEntry,
+			wantSource:   cachedEntry.URL,
+			wantSHA:      cachedEntry.SHA,
+			wantCached:   true,
+			wantFallback: true,
+			wantRequests: 2,
+		},
+		{
+			name:   "tampered cache is refetched",
+			server: fakeGithub{etag: `"cached"`},
+			cached: &cacheEntry{ETag: cachedEntry.ETag, SHA: cachedEntry.SHA, URL: cachedEntry.URL, Content: testFetchedYaml + "# tampered\n", Signature: cachedEntry.Signature},
+			// The tampered cache isn't revalidated, so the list is fetched even though its ETag matches
+			wantSource:   fetchedURL,
+			wantSHA:      "fetched-sha",
+			wantRequests: 2,
+		},
+		{
+			name:           "verification skipped",
+			server:         fakeGithub{etag: `"fetched"`, unsigned: true},
+			opts:           FetchOptions{Signatures: SignatureOptions{InsecureSkipVerify: true}},
+			wantSource:     fetchedURL,
+			wantSHA:        "fetched-sha",
+			wantUnverified: true,
+			wantRequests:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeGithub(t, &tt.server)
+			if !tt.untrusted && !tt.opts.Signatures.InsecureSkipVerify {
+				tt.opts.Signatures = trusted
+			}
+			tt.opts.CacheDir = t.TempDir()
+			cacheKey := cloud.AWSClassic.String()
+			if tt.opts.Ref != "" {
+				cacheKey += "@" + tt.opts.Ref
+			}
+			if tt.cached != nil {
+				if err := writeCacheEntry(filepath.Join(tt.opts.CacheDir, cacheKey+".json"), tt.cached); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := FetchEgressList(context.Background(), cloud.AWSClassic, tt.opts)
+			if len(tt.server.requests) != tt.wantRequests {
+				t.Errorf("FetchEgressList() made %d requests, want %d", len(tt.server.requests), tt.wantRequests)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FetchEgressList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Source != tt.wantSource || got.SHA != tt.wantSHA || got.Cached != tt.wantCached || got.Ref != tt.opts.Ref {
+				t.Errorf("FetchEgressList() = %+v, want source %q, SHA %q, cached %v", got, tt.wantSource, tt.wantSHA, tt.wantCached)
+			}
+			if got.Unverified != tt.wantUnverified {
+				t.Errorf("FetchEgressList() Unverified = %v, want %v", got.Unverified, tt.wantUnverified)
+			}
+			if (got.FallbackReason != "") != tt.wantFallback {
+				t.Errorf("FetchEgressList() FallbackReason = %q, wantFallback %v", got.FallbackReason, tt.wantFallback)
+			}
+
+			// Lists fetched from GitHub must be cached
+			if got.Source != SourceEmbedded && !got.Cached {
+				entry := readCacheEntry(filepath.Join(tt.opts.CacheDir, cacheKey+".json"))
+				if entry == nil || entry.SHA != got.SHA || entry.ETag != tt.server.etag {
+					t.Errorf("cache entry = %+v, want SHA %q and ETag %q", entry, got.SHA, tt.server.etag)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchEgressList_Token(t *testing.T) {
+	server := &fakeGithub{}
+	withFakeGithub(t, server)
+
+	if _, err := FetchEgressList(context.Background(), cloud.GCPClassic, FetchOptions{Token: "secret", Ref: "main", Signatures: SignatureOptions{InsecureSkipVerify: true}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(server.requests) != 1 {
+		t.Fatalf("FetchEgressList() made %d requests, want 1", len(server.requests))
+	}
+	r := server.requests[0]
+	if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("Authorization header = %q, want %q", got, "Bearer secret")
+	}
+	if got := r.URL.Query().Get("ref"); got != "main" {
+		t.Errorf("ref query parameter = %q, want %q", got, "main")
+	}
+}
+
+func TestFetchResult_Parse(t *testing.T) {
+	fetched := &FetchResult{Yaml: "endpoints: [", Source: "https://api.github.com/broken", SHA: "broken-sha"}
+	egressList, err := fetched.Parse(cloud.AWSClassic, map[string]string{VariableAWSRegion: "us-east-1"})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(egressList.Endpoints) == 0 {
+		t.Error("Parse() returned an empty list, want the embedded list")
+	}
+	if fetched.Source != SourceEmbedded || fetched.FallbackReason == "" {
+		t.Errorf("Parse() left result %+v, want the embedded list with a fallback reason", fetched)
+	}
+
+	pinned := &FetchResult{Yaml: "endpoints: [", Source: "https://api.github.com/broken", Ref: "v1.2.3"}
+	if _, err := pinned.Parse(cloud.AWSClassic, nil); err == nil {
+		t.Error("Parse() of a pinned list succeeded, want an error")
+	}
+}
@@ -0,0 +1,515 @@
+package egress_lists
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Issue is a problem found in an egress list by Lint
+type Issue struct {
+	// Line is the (1-based) line of the egress list the issue was found on. 0 if unknown
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	if i.Line == 0 {
+		return i.Message
+	}
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+// v2Fields lists the endpoint fields that require SchemaVersionV2
+var v2Fields = map[string]bool{
+	"category":          true,
+	"owner":             true,
+	"description":       true,
+	"docs":              true,
+	"required":          true,
+	"regions":           true,
+	"excludeRegions":    true,
+	"partitions":        true,
+	"protocol":          true,
+	"path":              true,
+	"method":            true,
+	"expectedStatus":    true,
+	"samples":           true,
+	"anyOf":             true,
+	"expectedAddresses": true,
+	"timeout":           true,
+	"retries":           true,
+}
+
+// httpFields lists the endpoint fields that only apply to http(s) endpoints
+var httpFields = []string{"path", "method", "expectedStatus"}
+
+var (
+	// placeholderPattern matches the ${VAR} and $VAR placeholders expanded by os.Expand
+	placeholderPattern = regexp.MustCompile(`\$(\{([^}]*)\}|[A-Za-z0-9_]+)`)
+	// hostnameLabelPattern matches a single DNS label, per RFC 1123
+	hostnameLabelPattern = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	// yamlErrorLinePattern extracts the line number from yaml.v3's error messages
+	yamlErrorLinePattern = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+)
+
+// Lint validates egressListYaml (an egress list or an Overlay) without expanding it, returning every
+// issue found in order of appearance. It reports YAML syntax errors, unknown keys, fields that don't match the list's
+// schema version, endpoints without a valid host or ports, duplicate host/port pairs, and
+// placeholders for variables not listed in variables. A nil result means the list is valid
+func Lint(egressListYaml string, variables []string) []Issue {
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(egressListYaml), root); err != nil {
+		return []Issue{yamlErrorIssue(err)}
+	}
+	if len(root.Content) == 0 {
+		return []Issue{{Line: 1, Message: "egress list is empty"}}
+	}
+
+	l := linter{
+		variables: map[string]bool{},
+		seen:      map[string]int{},
+		groups:    map[string][]groupMember{},
+	}
+	for _, name := range variables {
+		l.variables[name] = true
+	}
+	l.lintList(root.Content[0])
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		return l.issues[i].Line < l.issues[j].Line
+	})
+	return l.issues
+}
+
+type linter struct {
+	issues    []Issue
+	variables map[string]bool
+	version   string
+	// seen maps each "host:port" to the line it was first defined on
+	seen map[string]int
+	// groups maps each anyOf group to its members
+	groups map[string][]groupMember
+}
+
+// groupMember is the anyOf value of a member of an anyOf group, and the ports the member lists
+type groupMember struct {
+	anyOf *yaml.Node
+	ports string
+}
+
+func (l *linter) addIssue(node *yaml.Node, format string, a ...any) {
+	l.issues = append(l.issues, Issue{Line: node.Line, Message: fmt.Sprintf(format, a...)})
+}
+
+func (l *linter) lintList(list *yaml.Node) {
+	if list.Kind != yaml.MappingNode {
+		l.addIssue(list, "egress list must be a mapping with an 'endpoints' key")
+		return
+	}
+
+	// Overlays are identified by their sections, as they're written in the same schema as lists
+	isOverlay := false
+	for i := 0; i+1 < len(list.Content); i += 2 {
+		switch list.Content[i].Value {
+		case "add", "override", "remove":
+			isOverlay = true
+		}
+	}
+	knownKeys := yamlFieldNames(reflect.TypeOf(EgressList{}))
+	if isOverlay {
+		knownKeys = yamlFieldNames(reflect.TypeOf(Overlay{}))
+	}
+
+	var endpoints *yaml.Node
+	for i := 0; i+1 < len(list.Content); i += 2 {
+		key, value := list.Content[i], list.Content[i+1]
+		switch {
+		case !knownKeys[key.Value]:
+			l.addIssue(key, "unknown key '%s'", key.Value)
+		case key.Value == "version":
+			l.version = value.Value
+			if value.Value != SchemaVersionV1 && value.Value != SchemaVersionV2 {
+				l.addIssue(value, "unsupported schema version '%s', must be either '%s' or '%s'", value.Value, SchemaVersionV1, SchemaVersionV2)
+			}
+		case key.Value == "endpoints":
+			endpoints = value
+		}
+	}
+
+	if isOverlay {
+		l.lintOverlay(list)
+		return
+	}
+	if endpoints == nil {
+		l.addIssue(list, "missing 'endpoints' key")
+		return
+	}
+	if endpoints.Kind != yaml.SequenceNode {
+		l.addIssue(endpoints, "'endpoints' must be a list")
+		return
+	}
+	for _, endpoint := range endpoints.Content {
+		l.lintEndpoint(endpoint)
+	}
+	// Overlays may add members to a list's groups, so only complete lists are checked
+	for name, members := range l.groups {
+		if len(members) == 1 {
+			l.addIssue(members[0].anyOf, "anyOf group '%s' has only one member, so it has no alternatives", name)
+		}
+		// Members only stand in for each other on the same port
+		for _, member := range members[1:] {
+			if member.ports != members[0].ports {
+				l.addIssue(member.anyOf, "anyOf group '%s' members must list the same ports, got [%s] and [%s]", name, members[0].ports, member.ports)
+			}
+		}
+	}
+}
+
+// lintOverlay lints the sections of an Overlay. It must be called after the overlay's version was
+// recorded by lintList
+func (l *linter) lintOverlay(overlay *yaml.Node) {
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+		switch key.Value {
+		case "add", "override", "remove":
+		default:
+			continue
+		}
+		if value.Kind != yaml.SequenceNode {
+			l.addIssue(value, "'%s' must be a list", key.Value)
+			continue
+		}
+		for _, endpoint := range value.Content {
+			if key.Value == "remove" {
+				l.lintRemovedEndpoint(endpoint)
+			} else {
+				l.lintEndpoint(endpoint)
+			}
+		}
+	}
+}
+
+func (l *linter) lintRemovedEndpoint(endpoint *yaml.Node) {
+	if endpoint.Kind != yaml.MappingNode {
+		l.addIssue(endpoint, "removed endpoint must be a mapping with a 'host' key")
+		return
+	}
+
+	knownKeys := yamlFieldNames(reflect.TypeOf(RemovedEndpoint{}))
+	var host, ports *yaml.Node
+	for i := 0; i+1 < len(endpoint.Content); i += 2 {
+		key, value := endpoint.Content[i], endpoint.Content[i+1]
+		switch {
+		case !knownKeys[key.Value]:
+			l.addIssue(key, "unknown key '%s'", key.Value)
+		case key.Value == "host":
+			host = value
+		case key.Value == "ports":
+			ports = value
+		}
+	}
+
+	if host == nil || host.Value == "" {
+		l.addIssue(endpoint, "removed endpoint is missing a host")
+	} else {
+		l.lintHost(host)
+	}
+	if ports == nil {
+		return
+	}
+	if ports.Kind != yaml.SequenceNode {
+		l.addIssue(ports, "'ports' must be a list")
+		return
+	}
+	for _, portNode := range ports.Content {
+		l.lintPort(portNode)
+	}
+}
+
+// portList returns the sorted, comma-separated values of a 'ports' list, for comparison
+func portList(ports *yaml.Node) string {
+	if ports == nil {
+		return ""
+	}
+	var values []string
+	for _, port := range ports.Content {
+		values = append(values, port.Value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		a, _ := strconv.Atoi(values[i])
+		b, _ := strconv.Atoi(values[j])
+		return a < b
+	})
+	return strings.Join(values, ", ")
+}
+
+func (l *linter) lintEndpoint(endpoint *yaml.Node) {
+	if endpoint.Kind != yaml.MappingNode {
+		l.addIssue(endpoint, "endpoint must be a mapping with 'host' and 'ports' keys")
+		return
+	}
+
+	knownKeys := yamlFieldNames(reflect.TypeOf(Endpoint{}))
+	var host, ports, protocol, path, samplesKey, anyOf *yaml.Node
+	var httpKeys []*yaml.Node
+	for i := 0; i+1 < len(endpoint.Content); i += 2 {
+		key, value := endpoint.Content[i], endpoint.Content[i+1]
+		if !knownKeys[key.Value] {
+			l.addIssue(key, "unknown key '%s'", key.Value)
+			continue
+		}
+		if v2Fields[key.Value] && l.version != SchemaVersionV2 {
+			l.addIssue(key, "key '%s' requires 'version: %s'", key.Value, SchemaVersionV2)
+		}
+
+		switch key.Value {
+		case "host":
+			host = value
+		case "ports":
+			ports = value
+		case "tlsDisabled", "required":
+			var b bool
+			if err := value.Decode(&b); err != nil {
+				l.addIssue(value, "'%s' must be true or false, got '%s'", key.Value, value.Value)
+			}
+		case "regions", "excludeRegions", "partitions":
+			l.lintSelector(key.Value, value)
+		case "docs":
+			if !strings.HasPrefix(value.Value, "https://") && !strings.HasPrefix(value.Value, "http://") {
+				l.addIssue(value, "'docs' must be an http(s) URL, got '%s'", value.Value)
+			}
+		case "protocol":
+			protocol = value
+			if !slices.Contains(protocols, value.Value) {
+				l.addIssue(value, "unknown protocol '%s', must be one of %s", value.Value, strings.Join(protocols, ", "))
+			}
+		case "path":
+			path = value
+			if !strings.HasPrefix(value.Value, "/") || strings.ContainsAny(value.Value, " \t\n") {
+				l.addIssue(value, "invalid path '%s', must start with '/' and contain no whitespace", value.Value)
+			}
+		case "method":
+			if !methodPattern.MatchString(value.Value) {
+				l.addIssue(value, "invalid method '%s', must be an uppercase HTTP method", value.Value)
+			}
+		case "expectedStatus":
+			l.lintExpectedStatus(value)
+		case "expectedAddresses":
+			l.lintExpectedAddresses(value)
+		case "timeout":
+			if timeout, err := time.ParseDuration(value.Value); err != nil || timeout < MinTimeout {
+				l.addIssue(value, "invalid timeout '%s', must be a duration of at least %v such as '30s'", value.Value, MinTimeout)
+			}
+		case "retries":
+			if retries, err := strconv.Atoi(value.Value); err != nil || retries < 0 || retries > MaxRetries {
+				l.addIssue(value, "invalid retries '%s', must be between 0 and %d", value.Value, MaxRetries)
+			}
+		case "samples":
+			samplesKey = key
+			l.lintSamples(value)
+		case "anyOf":
+			if value.Kind != yaml.ScalarNode || value.Value == "" {
+				l.addIssue(value, "'anyOf' must be the non-empty name of a group")
+				continue
+			}
+			anyOf = value
+		}
+		if slices.Contains(httpFields, key.Value) {
+			httpKeys = append(httpKeys, key)
+		}
+	}
+	if anyOf != nil {
+		l.groups[anyOf.Value] = append(l.groups[anyOf.Value], groupMember{anyOf: anyOf, ports: portList(ports)})
+	}
+	if protocol != nil && protocol.Value != ProtocolHTTP && protocol.Value != ProtocolHTTPS {
+		for _, key := range httpKeys {
+			l.addIssue(key, "'%s' only applies to http and https endpoints, not '%s'", key.Value, protocol.Value)
+		}
+	}
+
+	if host == nil || host.Value == "" {
+		l.addIssue(endpoint, "endpoint is missing a host")
+	} else {
+		l.lintHost(host)
+		isWildcard := strings.HasPrefix(host.Value, wildcardPrefix)
+		if isWildcard && samplesKey == nil {
+			l.addIssue(host, "wildcard host '%s' has no samples, so it can't be tested", host.Value)
+		}
+		if !isWildcard && samplesKey != nil {
+			l.addIssue(samplesKey, "'samples' only applies to wildcard hosts, not '%s'", host.Value)
+		}
+	}
+
+	if ports == nil || (ports.Kind == yaml.SequenceNode && len(ports.Content) == 0) {
+		l.addIssue(endpoint, "endpoint has no ports, so it won't be tested")
+		return
+	}
+	if ports.Kind != yaml.SequenceNode {
+		l.addIssue(ports, "'ports' must be a list")
+		return
+	}
+	for _, portNode := range ports.Content {
+		port, ok := l.lintPort(portNode)
+		if !ok || host == nil || host.Value == "" {
+			continue
+		}
+		hostPort := net.JoinHostPort(host.Value, strconv.Itoa(port))
+		// Several paths of the same host and port may be tested
+		if path != nil {
+			hostPort += path.Value
+		}
+		if firstLine, ok := l.seen[hostPort]; ok {
+			l.addIssue(portNode, "duplicate endpoint %s (first defined on line %d)", hostPort, firstLine)
+			continue
+		}
+		l.seen[hostPort] = portNode.Line
+	}
+}
+
+// lintPort returns the port held by portNode, and whether it's valid
+func (l *linter) lintPort(portNode *yaml.Node) (int, bool) {
+	port, err := strconv.Atoi(portNode.Value)
+	if err != nil || portNode.Kind != yaml.ScalarNode {
+		l.addIssue(portNode, "invalid port '%s'", portNode.Value)
+		return 0, false
+	}
+	if port < 1 || port > 65535 {
+		l.addIssue(portNode, "port %d is outside the valid range 1-65535", port)
+		return 0, false
+	}
+	return port, true
+}
+
+func (l *linter) lintExpectedStatus(expectedStatus *yaml.Node) {
+	if expectedStatus.Kind != yaml.SequenceNode {
+		l.addIssue(expectedStatus, "'expectedStatus' must be a list")
+		return
+	}
+	for _, item := range expectedStatus.Content {
+		status, err := strconv.Atoi(item.Value)
+		if err != nil || item.Kind != yaml.ScalarNode || status < 100 || status > 599 {
+			l.addIssue(item, "invalid HTTP status '%s', must be between 100 and 599", item.Value)
+		}
+	}
+}
+
+func (l *linter) lintExpectedAddresses(expectedAddresses *yaml.Node) {
+	if expectedAddresses.Kind != yaml.SequenceNode || len(expectedAddresses.Content) == 0 {
+		l.addIssue(expectedAddresses, "'expectedAddresses' must be a non-empty list")
+		return
+	}
+	for _, item := range expectedAddresses.Content {
+		matches := placeholderPattern.FindAllStringSubmatch(item.Value, -1)
+		for _, match := range matches {
+			name := strings.TrimSuffix(strings.TrimPrefix(match[1], "{"), "}")
+			if !l.variables[name] {
+				l.addIssue(item, "unresolved variable '%s' in expected addresses '%s'", name, item.Value)
+			}
+		}
+		// CIDRs held in variables (e.g., ${VPC_CIDR}) can only be validated once expanded
+		if len(matches) > 0 {
+			continue
+		}
+		if err := validateAddressRange(item.Value); item.Kind != yaml.ScalarNode || err != nil {
+			l.addIssue(item, "invalid expected addresses '%s', must be '%s', '%s' or a CIDR", item.Value, AddressPrivate, AddressPublic)
+		}
+	}
+}
+
+func (l *linter) lintSamples(samples *yaml.Node) {
+	if samples.Kind != yaml.SequenceNode || len(samples.Content) == 0 {
+		l.addIssue(samples, "'samples' must be a non-empty list")
+		return
+	}
+	for _, item := range samples.Content {
+		// Samples replace the wildcard's "*", so each must be a valid hostname on its own
+		sample := placeholderPattern.ReplaceAllString(item.Value, "placeholder")
+		if item.Kind != yaml.ScalarNode || item.Value == "" || validateHostname(sample) != nil {
+			l.addIssue(item, "invalid sample '%s', must be one or more DNS labels", item.Value)
+		}
+	}
+}
+
+func (l *linter) lintSelector(name string, selector *yaml.Node) {
+	if selector.Kind != yaml.SequenceNode {
+		l.addIssue(selector, "'%s' must be a list", name)
+		return
+	}
+	for _, item := range selector.Content {
+		switch {
+		case item.Kind != yaml.ScalarNode || item.Value == "":
+			l.addIssue(item, "'%s' entries must be non-empty strings", name)
+		case name == "partitions" && !slices.Contains(awsPartitions, item.Value):
+			l.addIssue(item, "unknown AWS partition '%s', must be one of %s", item.Value, strings.Join(awsPartitions, ", "))
+		case name != "partitions":
+			if _, err := path.Match(item.Value, ""); err != nil {
+				l.addIssue(item, "invalid region pattern '%s': %s", item.Value, err)
+			}
+		}
+	}
+}
+
+func (l *linter) lintHost(host *yaml.Node) {
+	hostname := host.Value
+	for _, match := range placeholderPattern.FindAllStringSubmatch(hostname, -1) {
+		name := strings.TrimPrefix(match[1], "{")
+		name = strings.TrimSuffix(name, "}")
+		if !l.variables[name] {
+			l.addIssue(host, "unresolved variable '%s' in host '%s'", name, hostname)
+		}
+	}
+
+	// Validate the hostname as if each placeholder (and a leading wildcard) expanded to a valid label
+	expanded := placeholderPattern.ReplaceAllString(strings.TrimPrefix(hostname, wildcardPrefix), "placeholder")
+	if err := validateHostname(expanded); err != nil {
+		l.addIssue(host, "invalid host '%s': %s", hostname, err)
+	}
+}
+
+// validateHostname returns an error if host is neither an IP address nor a valid DNS name
+func validateHostname(host string) error {
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	if len(host) > 253 {
+		return errors.New("hostname is longer than 253 characters")
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(host, "."), ".") {
+		if !hostnameLabelPattern.MatchString(label) {
+			return fmt.Errorf("'%s' isn't a valid DNS label (letters, digits and hyphens only)", label)
+		}
+	}
+	return nil
+}
+
+// yamlErrorIssue converts a YAML syntax error into an Issue, extracting its line number if possible
+func yamlErrorIssue(err error) Issue {
+	if match := yamlErrorLinePattern.FindStringSubmatch(err.Error()); match != nil {
+		line, _ := strconv.Atoi(match[1])
+		return Issue{Line: line, Message: match[2]}
+	}
+	return Issue{Message: err.Error()}
+}
+
+// yamlFieldNames returns the set of YAML keys that can be decoded into the struct type t
+func yamlFieldNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
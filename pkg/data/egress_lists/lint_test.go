@@ -0,0 +1,399 @@
+package egress_lists
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name      string
+		yaml      string
+		variables []string
+		want      []Issue
+	}{
+		{
+			name: "valid v1 list",
+			yaml: `endpoints:
+  - host: ec2.${AWS_REGION}.amazonaws.com
+    ports:
+      - 443
+  - host: 10.0.0.1
+    ports:
+      - 9997
+    tlsDisabled: true
+`,
+			variables: []string{"AWS_REGION"},
+		},
+		{
+			name: "valid v2 list",
+			yaml: `version: v2
+endpoints:
+  - host: console.redhat.com
+    ports:
+      - 443
+    category: telemetry
+    docs: https://docs.example.com/telemetry
+    required: false
+`,
+		},
+		{
+			name: "syntax error",
+			yaml: "endpoints:\n  - host: quay.io\n\tports: [443]\n",
+			want: []Issue{{Line: 2, Message: "found a tab character that violates indentation"}},
+		},
+		{
+			name: "unknown keys",
+			yaml: `endpoint:
+  - host: quay.io
+endpoints:
+  - host: quay.io
+    port:
+      - 443
+`,
+			want: []Issue{
+				{Line: 1, Message: "unknown key 'endpoint'"},
+				{Line: 4, Message: "endpoint has no ports, so it won't be tested"},
+				{Line: 5, Message: "unknown key 'port'"},
+			},
+		},
+		{
+			name: "empty and invalid ports",
+			yaml: `endpoints:
+  - host: quay.io
+    ports: []
+  - host: registry.redhat.io
+    ports:
+      - 0
+      - 70000
+      - https
+`,
+			want: []Issue{
+				{Line: 2, Message: "endpoint has no ports, so it won't be tested"},
+				{Line: 6, Message: "port 0 is outside the valid range 1-65535"},
+				{Line: 7, Message: "port 70000 is outside the valid range 1-65535"},
+				{Line: 8, Message: "invalid port 'https'"},
+			},
+		},
+		{
+			name: "duplicate host and port",
+			yaml: `endpoints:
+  - host: quay.io
+    ports:
+      - 443
+      - 80
+  - host: quay.io
+    ports:
+      - 443
+`,
+			want: []Issue{
+				{Line: 8, Message: "duplicate endpoint quay.io:443 (first defined on line 4)"},
+			},
+		},
+		{
+			name: "invalid hostnames",
+			yaml: `endpoints:
+  - ports:
+      - 443
+  - host: https://quay.io
+    ports:
+      - 443
+  - host: -bad.example.com
+    ports:
+      - 443
+`,
+			want: []Issue{
+				{Line: 2, Message: "endpoint is missing a host"},
+				{Line: 4, Message: "invalid host 'https://quay.io': 'https://quay' isn't a valid DNS label (letters, digits and hyphens only)"},
+				{Line: 7, Message: "invalid host '-bad.example.com': '-bad' isn't a valid DNS label (letters, digits and hyphens only)"},
+			},
+		},
+		{
+			name: "unresolved variables",
+			yaml: `endpoints:
+  - host: ec2.${AWS_REGION}.amazonaws.com
+    ports:
+      - 443
+  - host: compute.$GCP_REGION.googleapis.com
+    ports:
+      - 443
+`,
+			variables: []string{},
+			want: []Issue{
+				{Line: 2, Message: "unresolved variable 'AWS_REGION' in host 'ec2.${AWS_REGION}.amazonaws.com'"},
+				{Line: 5, Message: "unresolved variable 'GCP_REGION' in host 'compute.$GCP_REGION.googleapis.com'"},
+			},
+		},
+		{
+			name: "schema version mismatches",
+			yaml: `version: v3
+endpoints:
+  - host: quay.io
+    ports:
+      - 443
+    required: false
+`,
+			want: []Issue{
+				{Line: 1, Message: "unsupported schema version 'v3', must be either 'v1' or 'v2'"},
+				{Line: 6, Message: "key 'required' requires 'version: v2'"},
+			},
+		},
+		{
+			name: "v2 fields in v1 list",
+			yaml: `endpoints:
+  - host: quay.io
+    ports:
+      - 443
+    description: Hosts release images
+`,
+			want: []Issue{
+				{Line: 5, Message: "key 'description' requires 'version: v2'"},
+			},
+		},
+		{
+			name: "invalid selectors",
+			yaml: `version: v2
+endpoints:
+  - host: quay.io
+    ports:
+      - 443
+    regions: us-east-1
+  - host: iam.us-gov.amazonaws.com
+    ports:
+      - 443
+    partitions:
+      - govcloud
+    excludeRegions:
+      - "us-[east-1"
+`,
+			want: []Issue{
+				{Line: 6, Message: "'regions' must be a list"},
+				{Line: 11, Message: "unknown AWS partition 'govcloud', must be one of aws, aws-cn, aws-us-gov, aws-iso, aws-iso-b, aws-iso-e, aws-iso-f"},
+				{Line: 13, Message: "invalid region pattern 'us-[east-1': syntax error in pattern"},
+			},
+		},
+		{
+			name: "invalid protocols and requests",
+			yaml: `version: v2
+endpoints:
+  - host: api.openshift.com
+    ports:
+      - 443
+    path: /healthz
+    method: GET
+    expectedStatus:
+      - 200
+  - host: api.openshift.com
+    ports:
+      - 443
+    path: /api
+  - host: splunk.example.com
+    ports:
+      - 9997
+    protocol: udp
+  - host: mirror.example.com
+    ports:
+      - 8443
+    protocol: tls
+    path: healthz
+    method: get
+    expectedStatus:
+      - 2000
+`,
+			want: []Issue{
+				{Line: 17, Message: "unknown protocol 'udp', must be one of http, https, tcp, tls"},
+				{Line: 22, Message: "invalid path 'healthz', must start with '/' and contain no whitespace"},
+				{Line: 22, Message: "'path' only applies to http and https endpoints, not 'tls'"},
+				{Line: 23, Message: "invalid method 'get', must be an uppercase HTTP method"},
+				{Line: 23, Message: "'method' only applies to http and https endpoints, not 'tls'"},
+				{Line: 24, Message: "'expectedStatus' only applies to http and https endpoints, not 'tls'"},
+				{Line: 25, Message: "invalid HTTP status '2000', must be between 100 and 599"},
+			},
+		},
+		{
+			name: "wildcard hosts",
+			yaml: `version: v2
+endpoints:
+  - host: "*.s3.amazonaws.com"
+    ports:
+      - 443
+    samples:
+      - my-bucket
+      - my-bucket.s3-accesspoint
+  - host: "*.apps.example.com"
+    ports:
+      - 443
+  - host: console.example.com
+    ports:
+      - 443
+    samples:
+      - www
+  - host: "*.registry.example.com"
+    ports:
+      - 443
+    samples:
+      - "*"
+      - -invalid
+  - host: "apps.*.example.com"
+    ports:
+      - 443
+    samples: []
+`,
+			want: []Issue{
+				{Line: 9, Message: "wildcard host '*.apps.example.com' has no samples, so it can't be tested"},
+				{Line: 15, Message: "'samples' only applies to wildcard hosts, not 'console.example.com'"},
+				{Line: 21, Message: "invalid sample '*', must be one or more DNS labels"},
+				{Line: 22, Message: "invalid sample '-invalid', must be one or more DNS labels"},
+				{Line: 23, Message: "invalid host 'apps.*.example.com': '*' isn't a valid DNS label (letters, digits and hyphens only)"},
+				{Line: 26, Message: "'samples' must be a non-empty list"},
+				{Line: 26, Message: "'samples' only applies to wildcard hosts, not 'apps.*.example.com'"},
+			},
+		},
+		{
+			name: "anyOf groups",
+			yaml: `version: v2
+endpoints:
+  - host: mirror-a.example.com
+    ports:
+      - 443
+    anyOf: mirrors
+  - host: mirror-b.example.com
+    ports:
+      - 443
+    anyOf: mirrors
+  - host: cdn.example.com
+    ports:
+      - 443
+    anyOf: cdns
+  - host: quay.io
+    ports:
+      - 443
+    anyOf: [mirrors]
+  - host: mirror-c.example.com
+    ports:
+      - 80
+      - 443
+    anyOf: mirrors
+`,
+			want: []Issue{
+				{Line: 14, Message: "anyOf group 'cdns' has only one member, so it has no alternatives"},
+				{Line: 18, Message: "'anyOf' must be the non-empty name of a group"},
+				{Line: 23, Message: "anyOf group 'mirrors' members must list the same ports, got [443] and [80, 443]"},
+			},
+		},
+		{
+			name: "expected addresses",
+			yaml: `version: v2
+endpoints:
+  - host: sts.us-east-1.amazonaws.com
+    ports:
+      - 443
+    expectedAddresses: [private, "${VPC_CIDR}", 10.0.0.0/33, internal]
+  - host: quay.io
+    ports:
+      - 443
+    expectedAddresses: public
+`,
+			want: []Issue{
+				{Line: 6, Message: "unresolved variable 'VPC_CIDR' in expected addresses '${VPC_CIDR}'"},
+				{Line: 6, Message: "invalid expected addresses '10.0.0.0/33', must be 'private', 'public' or a CIDR"},
+				{Line: 6, Message: "invalid expected addresses 'internal', must be 'private', 'public' or a CIDR"},
+				{Line: 10, Message: "'expectedAddresses' must be a non-empty list"},
+			},
+		},
+		{
+			name: "timeout and retries",
+			yaml: `version: v2
+endpoints:
+  - host: slow.example.com
+    ports:
+      - 443
+    timeout: 1m
+    retries: 5
+  - host: quay.io
+    ports:
+      - 443
+    timeout: 30
+    retries: many
+  - host: fast.example.com
+    ports:
+      - 443
+    timeout: 5ms
+`,
+			want: []Issue{
+				{Line: 11, Message: "invalid timeout '30', must be a duration of at least 10ms such as '30s'"},
+				{Line: 12, Message: "invalid retries 'many', must be between 0 and 10"},
+				{Line: 16, Message: "invalid timeout '5ms', must be a duration of at least 10ms such as '30s'"},
+			},
+		},
+		{
+			name: "valid overlay",
+			yaml: `version: v2
+add:
+  - host: registry.internal.example.com
+    ports:
+      - 443
+    required: false
+override:
+  - host: quay.io
+    ports:
+      - 443
+remove:
+  - host: www.okd.io
+  - host: api.openshift.com
+    ports:
+      - 443
+`,
+		},
+		{
+			name: "invalid overlay",
+			yaml: `add:
+  - host: registry.internal.example.com
+    port: 443
+endpoints: []
+remove:
+  - hosts: www.okd.io
+    ports:
+      - 99999
+`,
+			want: []Issue{
+				{Line: 2, Message: "endpoint has no ports, so it won't be tested"},
+				{Line: 3, Message: "unknown key 'port'"},
+				{Line: 4, Message: "unknown key 'endpoints'"},
+				{Line: 6, Message: "unknown key 'hosts'"},
+				{Line: 6, Message: "removed endpoint is missing a host"},
+				{Line: 8, Message: "port 99999 is outside the valid range 1-65535"},
+			},
+		},
+		{
+			name: "empty list",
+			yaml: "",
+			want: []Issue{{Line: 1, Message: "egress list is empty"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lint(tt.yaml, tt.variables)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbeddedEgressListsLint(t *testing.T) {
+	for _, platform := range []cloud.Platform{cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress, cloud.GCPClassic} {
+		t.Run(platform.String(), func(t *testing.T) {
+			egressListYaml, err := GetLocalEgressList(platform)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if issues := Lint(egressListYaml, VariableNames(platform)); len(issues) > 0 {
+				t.Errorf("embedded egress list has issues: %v", issues)
+			}
+		})
+	}
+}
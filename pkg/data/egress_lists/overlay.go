@@ -0,0 +1,136 @@
+package egress_lists
+
+import (
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Overlay modifies an egress list without replacing it, e.g., to add a customer's internal
+// registries to the platform's list. Overlays are written in the same schema as egress lists, but
+// hold "add", "override" and "remove" sections instead of "endpoints"
+type Overlay struct {
+	Version string `yaml:"version,omitempty"`
+	// Add lists endpoints to test in addition to the list's. Ports already tested for the same host
+	// are ignored
+	Add []Endpoint `yaml:"add,omitempty"`
+	// Override lists endpoints replacing every endpoint of the list with the same host
+	Override []Endpoint `yaml:"override,omitempty"`
+	// Remove lists endpoints to stop testing
+	Remove []RemovedEndpoint `yaml:"remove,omitempty"`
+}
+
+// RemovedEndpoint selects the endpoint(s) removed from an egress list by an Overlay
+type RemovedEndpoint struct {
+	Host string `yaml:"host"`
+	// Ports limits the removal to the given ports of Host. Empty means all ports
+	Ports []int `yaml:"ports,omitempty"`
+}
+
+// ParseOverlay expands the ${VAR} placeholders in overlayYamlStr using variables (see
+// ParseEgressList) and parses the result as an egress list overlay
+func ParseOverlay(overlayYamlStr string, variables map[string]string) (*Overlay, error) {
+	buf, err := expandVariables(overlayYamlStr, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	overlay := &Overlay{}
+	if err := yaml.Unmarshal(buf, overlay); err != nil {
+		return nil, err
+	}
+	switch overlay.Version {
+	case "", SchemaVersionV1, SchemaVersionV2:
+	default:
+		return nil, fmt.Errorf("unsupported egress list schema version '%s', must be either '%s' or '%s'", overlay.Version, SchemaVersionV1, SchemaVersionV2)
+	}
+	for _, endpoint := range overlay.Override {
+		if len(endpoint.Ports) == 0 {
+			return nil, fmt.Errorf("override of %s has no ports", endpoint.Host)
+		}
+	}
+	for _, endpoint := range append(slices.Clone(overlay.Add), overlay.Override...) {
+		if err := endpoint.validateVersion(overlay.Version); err != nil {
+			return nil, err
+		}
+		if err := endpoint.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return overlay, nil
+}
+
+// Apply returns a copy of the list with each of overlays applied in turn. Within each overlay,
+// endpoints are removed first, then overridden, then added. It returns an error if an overlay
+// overrides or removes a host that isn't in the list, as that's most likely a typo
+func (l *EgressList) Apply(overlays ...*Overlay) (*EgressList, error) {
+	merged := &EgressList{Version: l.Version, Endpoints: slices.Clone(l.Endpoints)}
+	for _, overlay := range overlays {
+		if overlay.Version == SchemaVersionV2 {
+			merged.Version = SchemaVersionV2
+		}
+
+		for _, removed := range overlay.Remove {
+			if !merged.hasHost(removed.Host) {
+				return nil, fmt.Errorf("can't remove %s: host isn't in the egress list", removed.Host)
+			}
+			for i, endpoint := range merged.Endpoints {
+				if endpoint.Host == removed.Host {
+					merged.Endpoints[i].Ports = slices.DeleteFunc(slices.Clone(endpoint.Ports), func(port int) bool {
+						return len(removed.Ports) == 0 || slices.Contains(removed.Ports, port)
+					})
+				}
+			}
+			// Drop endpoints left without any ports
+			merged.Endpoints = slices.DeleteFunc(merged.Endpoints, func(e Endpoint) bool {
+				return len(e.Ports) == 0
+			})
+		}
+
+		for _, override := range overlay.Override {
+			i := slices.IndexFunc(merged.Endpoints, func(e Endpoint) bool { return e.Host == override.Host })
+			if i < 0 {
+				return nil, fmt.Errorf("can't override %s: host isn't in the egress list", override.Host)
+			}
+			// Replace the first endpoint with the host in place, so that the list's order is kept
+			merged.Endpoints = slices.DeleteFunc(merged.Endpoints, func(e Endpoint) bool {
+				return e.Host == override.Host
+			})
+			merged.Endpoints = slices.Insert(merged.Endpoints, i, override)
+		}
+
+		for _, added := range overlay.Add {
+			added.Ports = slices.DeleteFunc(slices.Clone(added.Ports), func(port int) bool {
+				_, ok := merged.Lookup(added.Host, port)
+				return ok
+			})
+			if len(added.Ports) > 0 {
+				merged.Endpoints = append(merged.Endpoints, added)
+			}
+		}
+	}
+	return merged, nil
+}
+
+// hasHost returns true if any of the list's endpoints has the given host
+func (l *EgressList) hasHost(host string) bool {
+	return slices.ContainsFunc(l.Endpoints, func(e Endpoint) bool { return e.Host == host })
+}
+
+// ApplyOverlays parses each of overlayYamls (see ParseOverlay) and applies them to the list in order
+func (l *EgressList) ApplyOverlays(overlayYamls []string, variables map[string]string) (*EgressList, error) {
+	overlays := make([]*Overlay, 0, len(overlayYamls))
+	for i, overlayYaml := range overlayYamls {
+		overlay, err := ParseOverlay(overlayYaml, variables)
+		if err != nil {
+			return nil, fmt.Errorf("invalid egress list overlay %d: %w", i+1, err)
+		}
+		overlays = append(overlays, overlay)
+	}
+	merged, err := l.Apply(overlays...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply egress list overlays: %w", err)
+	}
+	return merged, nil
+}
@@ -0,0 +1,126 @@
+package egress_lists
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEgressList_Apply(t *testing.T) {
+	optional := false
+	base := &EgressList{Endpoints: []Endpoint{
+		{Host: "quay.io", Ports: []int{443}},
+		{Host: "api.openshift.com", Ports: []int{80, 443}},
+		{Host: "www.okd.io", Ports: []int{443}},
+	}}
+
+	tests := []struct {
+		name     string
+		overlays []*Overlay
+		want     *EgressList
+		wantErr  bool
+	}{
+		{
+			name: "no overlays",
+			want: base,
+		},
+		{
+			name: "add endpoints",
+			overlays: []*Overlay{{Add: []Endpoint{
+				{Host: "registry.internal.example.com", Ports: []int{443}},
+				{Host: "api.openshift.com", Ports: []int{443, 8443}},
+			}}},
+			want: &EgressList{Endpoints: []Endpoint{
+				{Host: "quay.io", Ports: []int{443}},
+				{Host: "api.openshift.com", Ports: []int{80, 443}},
+				{Host: "www.okd.io", Ports: []int{443}},
+				{Host: "registry.internal.example.com", Ports: []int{443}},
+				{Host: "api.openshift.com", Ports: []int{8443}},
+			}},
+		},
+		{
+			name: "override endpoint",
+			overlays: []*Overlay{{Version: SchemaVersionV2, Override: []Endpoint{
+				{Host: "api.openshift.com", Ports: []int{443}, Required: &optional},
+			}}},
+			want: &EgressList{Version: SchemaVersionV2, Endpoints: []Endpoint{
+				{Host: "quay.io", Ports: []int{443}},
+				{Host: "api.openshift.com", Ports: []int{443}, Required: &optional},
+				{Host: "www.okd.io", Ports: []int{443}},
+			}},
+		},
+		{
+			name: "remove host and port",
+			overlays: []*Overlay{{Remove: []RemovedEndpoint{
+				{Host: "www.okd.io"},
+				{Host: "api.openshift.com", Ports: []int{80}},
+			}}},
+			want: &EgressList{Endpoints: []Endpoint{
+				{Host: "quay.io", Ports: []int{443}},
+				{Host: "api.openshift.com", Ports: []int{443}},
+			}},
+		},
+		{
+			name: "overlays applied in order",
+			overlays: []*Overlay{
+				{Add: []Endpoint{{Host: "registry.internal.example.com", Ports: []int{443}}}},
+				{Remove: []RemovedEndpoint{{Host: "registry.internal.example.com"}}},
+			},
+			want: base,
+		},
+		{
+			name:     "override unknown host",
+			overlays: []*Overlay{{Override: []Endpoint{{Host: "quay.example.com", Ports: []int{443}}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "remove unknown host",
+			overlays: []*Overlay{{Remove: []RemovedEndpoint{{Host: "quay.example.com"}}}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := base.Apply(tt.overlays...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if len(base.Endpoints) != 3 || !reflect.DeepEqual(base.Endpoints[1].Ports, []int{80, 443}) {
+		t.Errorf("expected Apply not to modify the original list, got %+v", base)
+	}
+}
+
+func TestEgressList_ApplyOverlays(t *testing.T) {
+	base := &EgressList{Endpoints: []Endpoint{{Host: "quay.io", Ports: []int{443}}}}
+	overlayYaml := `
+add:
+  - host: registry.${BASE_DOMAIN}
+    ports:
+      - 443
+`
+
+	got, err := base.ApplyOverlays([]string{overlayYaml}, map[string]string{VariableBaseDomain: "example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := &EgressList{Endpoints: []Endpoint{
+		{Host: "quay.io", Ports: []int{443}},
+		{Host: "registry.example.com", Ports: []int{443}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := base.ApplyOverlays([]string{overlayYaml}, nil); err == nil {
+		t.Errorf("expected error for undefined variable")
+	}
+	if _, err := base.ApplyOverlays([]string{"add:\n  - host: mirror.example.com\n    ports: [443]\n    required: false\n"}, nil); err == nil {
+		t.Errorf("expected error for v2 field without 'version: v2'")
+	}
+}
@@ -0,0 +1,113 @@
+package egress_lists
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// SignatureSuffix is appended to the location of an egress list (or overlay) to get the location of
+// its detached signature, e.g., "aws-classic.yaml.sig"
+const SignatureSuffix = ".sig"
+
+var (
+	// ErrNoTrustedKeys is returned when verifying a signature without any trusted keys
+	ErrNoTrustedKeys = errors.New("no trusted egress list signing keys configured")
+	// ErrUnsigned is returned when verifying an egress list that has no signature
+	ErrUnsigned = errors.New("egress list is unsigned")
+)
+
+// SignatureOptions controls the verification of the detached ed25519 signatures of egress lists
+// fetched from remote locations. Signatures are computed over the list's exact bytes, and stored
+// either raw or base64-encoded, e.g., as produced by
+// "openssl pkeyutl -sign -rawin -inkey key.pem -in list.yaml -out list.yaml.sig"
+type SignatureOptions struct {
+	// TrustedKeys lists the public keys that lists may be signed with
+	TrustedKeys []ed25519.PublicKey
+	// InsecureSkipVerify accepts unsigned lists, and lists whose signature doesn't match any of
+	// TrustedKeys
+	InsecureSkipVerify bool
+}
+
+// required returns whether lists fetched from GitHub must have a valid signature, which is the
+// case unless verification is skipped. Having no trusted keys doesn't skip verification: it makes
+// every list fail it
+func (o SignatureOptions) required() bool {
+	return !o.InsecureSkipVerify
+}
+
+// Verify returns an error unless signature is a valid signature of content by one of the trusted
+// keys, or verification is skipped
+func (o SignatureOptions) Verify(content, signature []byte) error {
+	if o.InsecureSkipVerify {
+		return nil
+	}
+	if len(o.TrustedKeys) == 0 {
+		return ErrNoTrustedKeys
+	}
+	if len(signature) == 0 {
+		return ErrUnsigned
+	}
+	sig, err := DecodeSignature(signature)
+	if err != nil {
+		return err
+	}
+	for _, key := range o.TrustedKeys {
+		if ed25519.Verify(key, content, sig) {
+			return nil
+		}
+	}
+	return errors.New("egress list signature doesn't match any trusted key")
+}
+
+// DecodeSignature returns the raw ed25519 signature stored in a detached signature file, which may
+// hold either the raw signature or its base64 encoding
+func DecodeSignature(signature []byte) ([]byte, error) {
+	if len(signature) == ed25519.SignatureSize {
+		return signature, nil
+	}
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return nil, errors.New("invalid egress list signature: must be a raw or base64-encoded ed25519 signature")
+	}
+	return sig, nil
+}
+
+// ParsePublicKeys parses the ed25519 public keys in data, which may hold either any number of PEM
+// "PUBLIC KEY" blocks (e.g., as produced by "openssl pkey -pubout") or a single base64-encoded raw key
+func ParsePublicKeys(data []byte) ([]ed25519.PublicKey, error) {
+	var keys []ed25519.PublicKey
+	rest := data
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("unexpected PEM block type '%s', must be 'PUBLIC KEY'", block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key: %w", err)
+		}
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported public key type %T, must be ed25519", key)
+		}
+		keys = append(keys, edKey)
+	}
+	if len(keys) > 0 {
+		return keys, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(raw) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid public key: must be PEM-encoded or a base64-encoded raw ed25519 key")
+	}
+	return []ed25519.PublicKey{raw}, nil
+}
@@ -0,0 +1,136 @@
+package egress_lists
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSignatureOptions_Verify(t *testing.T) {
+	content := []byte(testFetchedYaml)
+	signature := ed25519.Sign(testSigningKey, content)
+	trusted := []ed25519.PublicKey{testSigningKey.Public().(ed25519.PublicKey)}
+
+	tests := []struct {
+		name      string
+		opts      SignatureOptions
+		content   []byte
+		signature []byte
+		wantErr   bool
+	}{
+		{
+			name:      "raw signature",
+			opts:      SignatureOptions{TrustedKeys: trusted},
+			content:   content,
+			signature: signature,
+		},
+		{
+			name:      "base64 signature",
+			opts:      SignatureOptions{TrustedKeys: trusted},
+			content:   content,
+			signature: []byte(base64.StdEncoding.EncodeToString(signature) + "\n"),
+		},
+		{
+			name:      "tampered content",
+			opts:      SignatureOptions{TrustedKeys: trusted},
+			content:   append([]byte("# tampered\n"), content...),
+			signature: signature,
+			wantErr:   true,
+		},
+		{
+			name:      "untrusted key",
+			opts:      SignatureOptions{TrustedKeys: []ed25519.PublicKey{make([]byte, ed25519.PublicKeySize)}},
+			content:   content,
+			signature: signature,
+			wantErr:   true,
+		},
+		{
+			name:    "unsigned",
+			opts:    SignatureOptions{TrustedKeys: trusted},
+			content: content,
+			wantErr: true,
+		},
+		{
+			name:      "malformed signature",
+			opts:      SignatureOptions{TrustedKeys: trusted},
+			content:   content,
+			signature: []byte("not a signature"),
+			wantErr:   true,
+		},
+		{
+			name:      "no trusted keys",
+			content:   content,
+			signature: signature,
+			wantErr:   true,
+		},
+		{
+			name:    "verification skipped",
+			opts:    SignatureOptions{InsecureSkipVerify: true},
+			content: content,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Verify(tt.content, tt.signature); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePublicKeys(t *testing.T) {
+	publicKey := testSigningKey.Public().(ed25519.PublicKey)
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    []ed25519.PublicKey
+		wantErr bool
+	}{
+		{
+			name: "PEM",
+			data: pemKey,
+			want: []ed25519.PublicKey{publicKey},
+		},
+		{
+			name: "multiple PEM blocks",
+			data: pemKey + pemKey,
+			want: []ed25519.PublicKey{publicKey, publicKey},
+		},
+		{
+			name: "base64",
+			data: base64.StdEncoding.EncodeToString(publicKey) + "\n",
+			want: []ed25519.PublicKey{publicKey},
+		},
+		{
+			name:    "private key",
+			data:    strings.ReplaceAll(pemKey, "PUBLIC KEY", "PRIVATE KEY"),
+			wantErr: true,
+		},
+		{
+			name:    "garbage",
+			data:    "not a key",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePublicKeys([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePublicKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePublicKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package egress_lists
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+)
+
+// Names of the documented ${VAR} placeholders that can be used in egress lists
+const (
+	VariableAWSRegion    = "AWS_REGION"
+	VariableGCPRegion    = "GCP_REGION"
+	VariableGCPProjectID = "GCP_PROJECT_ID"
+	VariableClusterName  = "CLUSTER_NAME"
+	VariableBaseDomain   = "BASE_DOMAIN"
+)
+
+// Variable documents a ${VAR} placeholder that can be used in egress lists
+type Variable struct {
+	Name        string
+	Description string
+	// Platforms lists the platforms for which the verifier defines the variable itself. Empty if
+	// the variable is available on every platform but must be supplied by the caller (e.g., via
+	// the egress subcommand's --egress-list-var flag)
+	Platforms []cloud.Platform
+}
+
+// Variables lists every documented egress list variable. Lists may also reference other variables,
+// as long as the caller supplies them
+var Variables = []Variable{
+	{
+		Name:        VariableAWSRegion,
+		Description: "Region of the subnet under test, e.g., us-east-1",
+		Platforms:   []cloud.Platform{cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress},
+	},
+	{
+		Name:        VariableGCPRegion,
+		Description: "Region of the subnet under test, e.g., us-east1",
+		Platforms:   []cloud.Platform{cloud.GCPClassic},
+	},
+	{
+		Name:        VariableGCPProjectID,
+		Description: "ID of the project containing the VPC under test",
+		Platforms:   []cloud.Platform{cloud.GCPClassic},
+	},
+	{
+		Name:        VariableClusterName,
+		Description: "Name of the cluster that will be installed into the subnet under test",
+	},
+	{
+		Name:        VariableBaseDomain,
+		Description: "Base DNS domain of the cluster that will be installed into the subnet under test, e.g., example.com",
+	},
+}
+
+// variableNamePattern matches the names os.Expand recognizes in ${VAR} placeholders
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// VariableNames returns the names of the documented variables that can be used in egress lists for
+// platformType: those the verifier defines for the platform, and those the caller must supply. If
+// platformType is unknown, the variables of every platform are returned
+func VariableNames(platformType cloud.Platform) []string {
+	var names []string
+	for _, v := range Variables {
+		if len(v.Platforms) == 0 || !platformType.IsValid() || slices.Contains(v.Platforms, platformType) {
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
+
+// ValidateVariables returns an error if any of the names in variables can't be referenced by an
+// egress list placeholder
+func ValidateVariables(variables map[string]string) error {
+	for name := range variables {
+		if !variableNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid egress list variable name '%s', must only contain letters, digits and underscores and not start with a digit", name)
+		}
+	}
+	return nil
+}
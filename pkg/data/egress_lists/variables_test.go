@@ -0,0 +1,71 @@
+package egress_lists
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+)
+
+func TestVariableNames(t *testing.T) {
+	tests := []struct {
+		platform cloud.Platform
+		want     []string
+	}{
+		{
+			platform: cloud.AWSHCP,
+			want:     []string{VariableAWSRegion, VariableClusterName, VariableBaseDomain},
+		},
+		{
+			platform: cloud.GCPClassic,
+			want:     []string{VariableGCPRegion, VariableGCPProjectID, VariableClusterName, VariableBaseDomain},
+		},
+		{
+			platform: cloud.Platform{},
+			want:     []string{VariableAWSRegion, VariableGCPRegion, VariableGCPProjectID, VariableClusterName, VariableBaseDomain},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.platform.String(), func(t *testing.T) {
+			if got := VariableNames(tt.platform); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]string
+		wantErr   bool
+	}{
+		{
+			name:      "documented and custom variables",
+			variables: map[string]string{VariableClusterName: "my-cluster", "MIRROR_HOST": "mirror.example.com"},
+		},
+		{
+			name:      "no variables",
+			variables: nil,
+		},
+		{
+			name:      "invalid name",
+			variables: map[string]string{"CLUSTER-NAME": "my-cluster"},
+			wantErr:   true,
+		},
+		{
+			name:      "name starting with a digit",
+			variables: map[string]string{"1REGION": "us-east-1"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateVariables(tt.variables); (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package errors
+
+// Code is a stable identifier for a class of problem encountered by the verifier, e.g.,
+// "ONV-IAM-001". Unlike error messages, codes never change between releases, so automation can
+// safely match on them. Codes may be added over time, so consumers should handle unknown codes
+type Code string
+
+// Category coarsely groups codes by the component responsible for the problem, e.g., "iam"
+type Category string
+
+const (
+	// CategoryIAM is used when the verifier's cloud credentials lack a required permission
+	CategoryIAM Category = "iam"
+	// CategoryCredentials is used when the verifier's cloud credentials are missing, invalid, or
+	// expired
+	CategoryCredentials Category = "credentials"
+	// CategoryCloudAPI is used for cloud provider API failures unrelated to permissions
+	CategoryCloudAPI Category = "cloud-api"
+	// CategoryEgress is used when the network under test blocks or misroutes egress traffic
+	CategoryEgress Category = "egress"
+	// CategoryDNS is used when the network under test's DNS configuration is invalid
+	CategoryDNS Category = "dns"
+	// CategoryProbe is used when the probe instance fails to report usable results
+	CategoryProbe Category = "probe"
+	// CategoryConfiguration is used when the verifier was given invalid input
+	CategoryConfiguration Category = "configuration"
+	// CategoryInternal is used for all other, unclassified errors
+	CategoryInternal Category = "internal"
+)
+
+const (
+	// CodeIAMPermissionDenied means the verifier's credentials lack a required permission
+	CodeIAMPermissionDenied Code = "ONV-IAM-001"
+	// CodeCredentialsInvalid means the verifier's credentials were rejected by the cloud provider
+	CodeCredentialsInvalid Code = "ONV-CRED-001"
+	// CodeCloudAPIThrottled means a cloud API request was rate-limited
+	CodeCloudAPIThrottled Code = "ONV-CLOUD-THROTTLED"
+	// CodeCloudAPIError means a cloud API request failed for any other reason
+	CodeCloudAPIError Code = "ONV-CLOUD-API"
+	// CodeEgressBlocked means the probe couldn't reach an egress endpoint
+	CodeEgressBlocked Code = "ONV-EGRESS-BLOCKED"
+	// CodeEgressDNS means an egress endpoint's hostname couldn't be resolved
+	CodeEgressDNS Code = "ONV-EGRESS-DNS"
+	// CodeEgressRefused means the connection to an egress endpoint was refused or couldn't be
+	// established
+	CodeEgressRefused Code = "ONV-EGRESS-REFUSED"
+	// CodeEgressTimeout means the request to an egress endpoint timed out
+	CodeEgressTimeout Code = "ONV-EGRESS-TIMEOUT"
+	// CodeEgressReset means the connection to an egress endpoint was closed or reset before a
+	// response was received
+	CodeEgressReset Code = "ONV-EGRESS-RESET"
+	// CodeEgressTLS means the TLS handshake with an egress endpoint failed
+	CodeEgressTLS Code = "ONV-EGRESS-TLS"
+	// CodeEgressCertificate means an egress endpoint's TLS certificate couldn't be verified
+	CodeEgressCertificate Code = "ONV-EGRESS-CERT"
+	// CodeEgressProxy means a proxy rejected the request to an egress endpoint or couldn't be
+	// reached
+	CodeEgressProxy Code = "ONV-EGRESS-PROXY"
+	// CodeEgressNonPrivate means an egress endpoint resolved to a public address where a private
+	// one was required
+	CodeEgressNonPrivate Code = "ONV-EGRESS-NONPRIVATE"
+	// CodeEgressHTTPStatus means an egress endpoint responded with an unexpected HTTP status code
+	CodeEgressHTTPStatus Code = "ONV-EGRESS-STATUS"
+	// CodeEgressAddress means an egress endpoint was reached at an address outside the ranges its
+	// egress list expects
+	CodeEgressAddress Code = "ONV-EGRESS-ADDRESS"
+	// CodeDNSAttributeDisabled means a VPC attribute required for DNS resolution is disabled
+	CodeDNSAttributeDisabled Code = "ONV-DNS-ATTRIBUTE"
+	// CodeProbeCorrupt means the probe's output couldn't be parsed
+	CodeProbeCorrupt Code = "ONV-PROBE-CORRUPT"
+	// CodeProbeTimeout means the probe didn't finish reporting its results in time
+	CodeProbeTimeout Code = "ONV-PROBE-TIMEOUT"
+	// CodeInvalidConfiguration means the verifier was given invalid input
+	CodeInvalidConfiguration Code = "ONV-CONFIG-INVALID"
+	// CodeInternal is used for all other, unclassified errors
+	CodeInternal Code = "ONV-INTERNAL"
+)
+
+type codeInfo struct {
+	category    Category
+	remediation string
+}
+
+var codes = map[Code]codeInfo{
+	CodeIAMPermissionDenied: {
+		category:    CategoryIAM,
+		remediation: "Grant the missing permission to the credentials used by the verifier. See the IAM permissions section of the docs for the full list of required permissions.",
+	},
+	CodeCredentialsInvalid: {
+		category:    CategoryCredentials,
+		remediation: "Check that the verifier's cloud credentials (environment variables or profile) are set, valid, and not expired.",
+	},
+	CodeCloudAPIThrottled: {
+		category:    CategoryCloudAPI,
+		remediation: "The cloud provider is rate-limiting API requests. Wait a few minutes and run the verifier again.",
+	},
+	CodeCloudAPIError: {
+		category:    CategoryCloudAPI,
+		remediation: "Check the cloud provider's error message. Transient errors can usually be resolved by running the verifier again.",
+	},
+	CodeEgressBlocked: {
+		category:    CategoryEgress,
+		remediation: "Ensure that the subnet's route table, security groups, network ACLs, and any firewall or proxy allow outbound traffic to this endpoint.",
+	},
+	CodeEgressDNS: {
+		category:    CategoryEgress,
+		remediation: "Ensure that the VPC's DNS resolver (or any custom DNS servers in its DHCP options) can resolve this hostname.",
+	},
+	CodeEgressRefused: {
+		category:    CategoryEgress,
+		remediation: "The connection was actively refused or no route exists. Check the subnet's route table and network ACLs, and any firewall that may reject traffic to this endpoint.",
+	},
+	CodeEgressTimeout: {
+		category:    CategoryEgress,
+		remediation: "Traffic to this endpoint is likely being silently dropped. Check the subnet's route table (e.g., NAT gateway), security groups, network ACLs, and any firewall rules.",
+	},
+	CodeEgressReset: {
+		category:    CategoryEgress,
+		remediation: "The connection was closed after it was established, which usually means a firewall or proxy is filtering this endpoint (e.g., by SNI). Allow this endpoint in the firewall's rules.",
+	},
+	CodeEgressTLS: {
+		category:    CategoryEgress,
+		remediation: "The TLS handshake failed. Check whether a firewall or TLS-inspecting proxy is interfering with traffic to this endpoint.",
+	},
+	CodeEgressCertificate: {
+		category:    CategoryEgress,
+		remediation: "The endpoint's certificate wasn't trusted, which usually means a TLS-inspecting proxy re-signed it. Exempt this endpoint from TLS inspection, or pass the proxy's CA bundle via --cacert.",
+	},
+	CodeEgressProxy: {
+		category:    CategoryEgress,
+		remediation: "The proxy rejected the request or couldn't be reached. Check the proxy's address and credentials, and that its allowlist includes this endpoint.",
+	},
+	CodeEgressNonPrivate: {
+		category:    CategoryEgress,
+		remediation: "Ensure that this endpoint resolves to a private address, e.g., via a VPC endpoint or a private DNS zone.",
+	},
+	CodeEgressHTTPStatus: {
+		category:    CategoryEgress,
+		remediation: "The endpoint was reached, but responded with an unexpected status. Check whether a proxy or firewall is intercepting requests to this endpoint (e.g., with a block page), or update the egress list's expectedStatus.",
+	},
+	CodeEgressAddress: {
+		category:    CategoryEgress,
+		remediation: "The endpoint was reached at an unexpected address. Check the DNS records it resolves to (e.g., VPC endpoints or private DNS zones), or update the egress list's expectedAddresses.",
+	},
+	CodeDNSAttributeDisabled: {
+		category:    CategoryDNS,
+		remediation: "Enable both the enableDnsSupport and enableDnsHostnames attributes on the VPC.",
+	},
+	CodeProbeCorrupt: {
+		category:    CategoryProbe,
+		remediation: "Run the verifier again with --debug to capture the probe instance's console output. If the problem persists, check that the instance image is supported.",
+	},
+	CodeProbeTimeout: {
+		category:    CategoryProbe,
+		remediation: "The probe instance didn't report results in time. Check that it can boot in the subnet and run the verifier again, optionally with --skip-termination to inspect the instance.",
+	},
+	CodeInvalidConfiguration: {
+		category:    CategoryConfiguration,
+		remediation: "Check the flags and inputs passed to the verifier.",
+	},
+	CodeInternal: {
+		category:    CategoryInternal,
+		remediation: "",
+	},
+}
+
+// Category returns the category the code belongs to. Unknown codes belong to CategoryInternal
+func (c Code) Category() Category {
+	if info, ok := codes[c]; ok {
+		return info.category
+	}
+	return CategoryInternal
+}
+
+// Remediation returns a short, human-readable suggestion for resolving problems with this code.
+// Empty if no general suggestion applies
+func (c Code) Remediation() string {
+	return codes[c].remediation
+}
@@ -3,14 +3,21 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/aws/smithy-go"
+	"google.golang.org/api/googleapi"
 )
 
+// genericErrorPrefix is prepended to the message of errors that NewGenericError doesn't otherwise classify
+const genericErrorPrefix = "network verifier error: "
+
 type GenericError struct {
 	egressURL string
 	message   string
+	code      Code
+	err       error
 }
 
 func (e *GenericError) Error() string {
@@ -21,17 +28,55 @@ func (e *GenericError) EgressURL() string {
 	return e.egressURL
 }
 
+// Code returns the error's stable identifier, defaulting to CodeInternal
+func (e *GenericError) Code() Code {
+	if e.code == "" {
+		return CodeInternal
+	}
+	return e.code
+}
+
+// Category returns the category of the error's code
+func (e *GenericError) Category() Category {
+	return e.Code().Category()
+}
+
+// Remediation returns a short suggestion for resolving the error, if any
+func (e *GenericError) Remediation() string {
+	return e.Code().Remediation()
+}
+
+// Unwrap returns the error this error was built from, if any
+func (e *GenericError) Unwrap() error {
+	return e.err
+}
+
+// WithCode returns a copy of the error with its code replaced by the provided one
+func (e *GenericError) WithCode(code Code) *GenericError {
+	c := *e
+	c.code = code
+	return &c
+}
+
 // Ensure GenericError implements the error interface
 var _ error = &GenericError{}
 
 // NewGenericError does some preprocessing if the provided error contains an aws-sdk-go-v2 error, otherwise just
-// prepends `network verifier error: `
+// prepends `network verifier error: `. Errors from the AWS and GCP APIs are assigned a code based on the API's error
+// code, errors that already carry a code keep it, and all other errors are assigned CodeInternal. A *GenericError is
+// returned as is, and the prefix isn't repeated for errors wrapping one
 func NewGenericError(err error) *GenericError {
 	var (
-		oe *smithy.OperationError
-		ae smithy.APIError
+		oe  *smithy.OperationError
+		ae  smithy.APIError
+		gae *googleapi.Error
+		ge  *GenericError
 	)
 
+	if e, ok := err.(*GenericError); ok {
+		return e
+	}
+
 	// Generically aws-sdk-go-v2 errors
 	if errors.As(err, &oe) {
 		if errors.As(oe.Unwrap(), &ae) {
@@ -39,25 +84,80 @@ func NewGenericError(err error) *GenericError {
 			case ae.ErrorCode() == "UnauthorizedOperation":
 				return &GenericError{
 					message: fmt.Sprintf("missing required permission %s:%s with error: %s", strings.ToLower(oe.Service()), oe.Operation(), oe.Error()),
+					code:    CodeIAMPermissionDenied,
+					err:     err,
 				}
 			default:
 				return &GenericError{
 					message: fmt.Sprintf("error performing %s:%s: %s", strings.ToLower(oe.Service()), oe.Operation(), ae.ErrorMessage()),
+					code:    awsErrorCode(ae.ErrorCode()),
+					err:     err,
 				}
 			}
 		}
 	}
 
+	code := CodeInternal
+	message := err.Error()
+	switch {
+	case errors.As(err, &ge):
+		code = ge.Code()
+		// The prefix is added to the whole message below, so drop the wrapped error's own prefix,
+		// leaving the context it was wrapped with (e.g., by fmt.Errorf's "%w") untouched
+		if context, ok := strings.CutSuffix(message, ge.Error()); ok {
+			message = context + strings.TrimPrefix(ge.Error(), genericErrorPrefix)
+		}
+	case errors.As(err, &gae):
+		code = gcpErrorCode(gae.Code)
+	}
+
 	// Just feed forward other generic errors
 	return &GenericError{
-		message: fmt.Sprintf("network verifier error: %s", err),
+		message: genericErrorPrefix + message,
+		code:    code,
+		err:     err,
 	}
 }
 
 // NewEgressURLError prepends the provided message with `egressURL error: `
 func NewEgressURLError(url string) error {
+	return NewEgressURLErrorWithCode(url, CodeEgressBlocked)
+}
+
+// NewEgressURLErrorWithCode is identical to NewEgressURLError, except the returned error carries the provided code
+// instead of CodeEgressBlocked
+func NewEgressURLErrorWithCode(url string, code Code) error {
 	return &GenericError{
 		egressURL: url,
 		message:   fmt.Sprintf("egressURL error: %s", url),
+		code:      code,
+	}
+}
+
+// awsErrorCode maps an AWS API error code (other than UnauthorizedOperation) to a Code
+func awsErrorCode(apiErrorCode string) Code {
+	switch apiErrorCode {
+	case "AccessDenied", "AccessDeniedException":
+		return CodeIAMPermissionDenied
+	case "AuthFailure", "InvalidClientTokenId", "UnrecognizedClientException", "ExpiredToken", "RequestExpired", "SignatureDoesNotMatch":
+		return CodeCredentialsInvalid
+	case "RequestLimitExceeded", "Throttling", "ThrottlingException":
+		return CodeCloudAPIThrottled
+	default:
+		return CodeCloudAPIError
+	}
+}
+
+// gcpErrorCode maps the HTTP status code of a GCP API error to a Code
+func gcpErrorCode(httpStatusCode int) Code {
+	switch httpStatusCode {
+	case http.StatusForbidden:
+		return CodeIAMPermissionDenied
+	case http.StatusUnauthorized:
+		return CodeCredentialsInvalid
+	case http.StatusTooManyRequests:
+		return CodeCloudAPIThrottled
+	default:
+		return CodeCloudAPIError
 	}
 }
@@ -2,7 +2,12 @@ package errors
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+
+	"github.com/aws/smithy-go"
+	"google.golang.org/api/googleapi"
 )
 
 func TestNewEgressURLError(t *testing.T) {
@@ -22,7 +27,141 @@ func TestNewEgressURLError(t *testing.T) {
 				if nve.egressURL != test.url {
 					t.Errorf("expected %v, got %v", test.url, nve.egressURL)
 				}
+				if nve.Code() != CodeEgressBlocked {
+					t.Errorf("expected code %v, got %v", CodeEgressBlocked, nve.Code())
+				}
+			}
+		})
+	}
+}
+
+func TestNewGenericError_Code(t *testing.T) {
+	awsError := func(code string) error {
+		return &smithy.OperationError{
+			ServiceID:     "EC2",
+			OperationName: "RunInstances",
+			Err:           &smithy.GenericAPIError{Code: code, Message: "oops"},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		err          error
+		wantCode     Code
+		wantCategory Category
+	}{
+		{
+			name:         "aws missing permission",
+			err:          awsError("UnauthorizedOperation"),
+			wantCode:     CodeIAMPermissionDenied,
+			wantCategory: CategoryIAM,
+		},
+		{
+			name:         "aws invalid credentials",
+			err:          awsError("AuthFailure"),
+			wantCode:     CodeCredentialsInvalid,
+			wantCategory: CategoryCredentials,
+		},
+		{
+			name:         "aws throttling",
+			err:          awsError("RequestLimitExceeded"),
+			wantCode:     CodeCloudAPIThrottled,
+			wantCategory: CategoryCloudAPI,
+		},
+		{
+			name:         "aws other",
+			err:          awsError("InvalidSubnetID.NotFound"),
+			wantCode:     CodeCloudAPIError,
+			wantCategory: CategoryCloudAPI,
+		},
+		{
+			name:         "gcp permission denied",
+			err:          fmt.Errorf("unable to create instance: %w", &googleapi.Error{Code: http.StatusForbidden}),
+			wantCode:     CodeIAMPermissionDenied,
+			wantCategory: CategoryIAM,
+		},
+		{
+			name:         "already coded",
+			err:          fmt.Errorf("wrapped: %w", NewGenericError(errors.New("bad output")).WithCode(CodeProbeCorrupt)),
+			wantCode:     CodeProbeCorrupt,
+			wantCategory: CategoryProbe,
+		},
+		{
+			name:         "unclassified",
+			err:          errors.New("idk"),
+			wantCode:     CodeInternal,
+			wantCategory: CategoryInternal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var nve *GenericError
+			if !errors.As(fmt.Errorf("outer: %w", NewGenericError(test.err)), &nve) {
+				t.Fatalf("expected a *GenericError")
+			}
+			if nve.Code() != test.wantCode {
+				t.Errorf("expected code %v, got %v", test.wantCode, nve.Code())
+			}
+			if nve.Category() != test.wantCategory {
+				t.Errorf("expected category %v, got %v", test.wantCategory, nve.Category())
+			}
+			if !errors.Is(nve, test.err) {
+				t.Errorf("expected %v to unwrap to %v", nve, test.err)
+			}
+		})
+	}
+}
+
+func TestNewGenericError_Message(t *testing.T) {
+	generic := NewGenericError(errors.New("invalid platform type")).WithCode(CodeInvalidConfiguration)
+
+	tests := []struct {
+		name        string
+		err         error
+		wantMessage string
+	}{
+		{
+			name:        "plain error",
+			err:         errors.New("idk"),
+			wantMessage: "network verifier error: idk",
+		},
+		{
+			name:        "generic error",
+			err:         generic,
+			wantMessage: "network verifier error: invalid platform type",
+		},
+		{
+			name:        "wrapped generic error",
+			err:         fmt.Errorf("failed to determine default machine image: %w", generic),
+			wantMessage: "network verifier error: failed to determine default machine image: invalid platform type",
+		},
+		{
+			name:        "wrapped generic error with the prefix in its context",
+			err:         fmt.Errorf("retrying after 'network verifier error: timeout': %w", generic),
+			wantMessage: "network verifier error: retrying after 'network verifier error: timeout': invalid platform type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NewGenericError(test.err).Error(); got != test.wantMessage {
+				t.Errorf("expected message %q, got %q", test.wantMessage, got)
 			}
 		})
 	}
+	if NewGenericError(generic) != generic {
+		t.Errorf("expected a *GenericError to be returned as is")
+	}
+}
+
+func TestCode_Remediation(t *testing.T) {
+	for code := range codes {
+		if code != CodeInternal && code.Remediation() == "" {
+			t.Errorf("expected %v to have a remediation", code)
+		}
+	}
+	if Code("ONV-UNKNOWN").Category() != CategoryInternal {
+		t.Errorf("expected unknown codes to belong to %v", CategoryInternal)
+	}
 }
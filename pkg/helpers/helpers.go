@@ -22,6 +22,9 @@ func RandSeq(n int) string {
 	return string(b)
 }
 
+// ErrWaitTimeout is returned by PollImmediate when the condition isn't met before the timeout
+var ErrWaitTimeout = errors.New("timed out waiting for the condition")
+
 // PollImmediate calls the condition function at the specified interval up to the specified timeout
 // until the condition function returns true or an error
 func PollImmediate(interval time.Duration, timeout time.Duration, condition func() (bool, error)) error {
@@ -41,7 +44,7 @@ func PollImmediate(interval time.Duration, timeout time.Duration, condition func
 		totalTime += interval
 	}
 
-	return errors.New("timed out waiting for the condition")
+	return ErrWaitTimeout
 }
 
 // IPPermissionsEquivalent compares two AWS IpPermissions (used in security group rules)
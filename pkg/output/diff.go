@@ -0,0 +1,242 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+// EndpointChange pairs the results of the same endpoint (identified by its URL and, for merged
+// outputs, its source) from two runs. Before or After is nil if the endpoint was only tested in one
+// of the runs
+type EndpointChange struct {
+	URL    string          `json:"url"`
+	Source string          `json:"source,omitempty"`
+	Before *EndpointResult `json:"before,omitempty"`
+	After  *EndpointResult `json:"after,omitempty"`
+}
+
+// label returns the change's URL, prefixed with its source if any
+func (c EndpointChange) label() string {
+	if c.Source != "" {
+		return fmt.Sprintf("%s: %s", c.Source, c.URL)
+	}
+	return c.URL
+}
+
+// endpointKey identifies an endpoint across runs
+type endpointKey struct {
+	source string
+	url    string
+}
+
+// Diff describes how the results of a verifier run changed relative to an earlier run
+type Diff struct {
+	// NewlyFailing holds endpoints that failed in the later run but passed (or weren't tested) in
+	// the earlier one
+	NewlyFailing []EndpointChange `json:"newlyFailing"`
+	// NewlyPassing holds endpoints that passed in the later run but failed (or weren't tested) in
+	// the earlier one
+	NewlyPassing []EndpointChange `json:"newlyPassing"`
+	// Unchanged holds endpoints with the same status in both runs
+	Unchanged []EndpointChange `json:"unchanged"`
+	// Removed holds endpoints that were only tested in the earlier run
+	Removed []EndpointChange `json:"removed"`
+	// NewFailures and ResolvedFailures compare failures not backed by endpoint results (e.g., from
+	// the legacy probe). They're only populated when neither run recorded endpoint results
+	NewFailures        []ErrorItem `json:"newFailures"`
+	ResolvedFailures   []ErrorItem `json:"resolvedFailures"`
+	NewExceptions      []ErrorItem `json:"newExceptions"`
+	ResolvedExceptions []ErrorItem `json:"resolvedExceptions"`
+	NewErrors          []ErrorItem `json:"newErrors"`
+	ResolvedErrors     []ErrorItem `json:"resolvedErrors"`
+}
+
+// ReadDocument decodes a JSON document previously produced by Output.WriteTo or Output.MarshalJSON
+func ReadDocument(r io.Reader) (*Document, error) {
+	doc := &Document{}
+	if err := json.NewDecoder(r).Decode(doc); err != nil {
+		return nil, fmt.Errorf("unable to decode verifier output: %w", err)
+	}
+	if doc.SchemaVersion != JSONSchemaVersion {
+		return nil, fmt.Errorf("unsupported verifier output schema version '%s', expected '%s'", doc.SchemaVersion, JSONSchemaVersion)
+	}
+	return doc, nil
+}
+
+// DiffDocuments compares the results of two verifier runs. Endpoints are matched by source and URL, and
+// failures, exceptions, and errors as described by errorItemKey
+func DiffDocuments(before, after *Document) *Diff {
+	d := &Diff{
+		NewlyFailing: []EndpointChange{},
+		NewlyPassing: []EndpointChange{},
+		Unchanged:    []EndpointChange{},
+		Removed:      []EndpointChange{},
+	}
+
+	beforeEndpoints := map[endpointKey]*EndpointResult{}
+	for i := range before.Endpoints {
+		b := &before.Endpoints[i]
+		beforeEndpoints[endpointKey{b.Source, b.URL}] = b
+	}
+	seen := map[endpointKey]bool{}
+	for i := range after.Endpoints {
+		a := &after.Endpoints[i]
+		key := endpointKey{a.Source, a.URL}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		change := EndpointChange{URL: a.URL, Source: a.Source, Before: beforeEndpoints[key], After: a}
+		switch {
+		case change.Before != nil && change.Before.Status == a.Status:
+			d.Unchanged = append(d.Unchanged, change)
+		case a.Passed():
+			d.NewlyPassing = append(d.NewlyPassing, change)
+		default:
+			d.NewlyFailing = append(d.NewlyFailing, change)
+		}
+	}
+	for i := range before.Endpoints {
+		b := &before.Endpoints[i]
+		key := endpointKey{b.Source, b.URL}
+		if !seen[key] {
+			seen[key] = true
+			d.Removed = append(d.Removed, EndpointChange{URL: b.URL, Source: b.Source, Before: b})
+		}
+	}
+
+	if len(before.Endpoints) == 0 && len(after.Endpoints) == 0 {
+		d.NewFailures, d.ResolvedFailures = diffErrorItems(before.Failures, after.Failures)
+	} else {
+		d.NewFailures, d.ResolvedFailures = []ErrorItem{}, []ErrorItem{}
+	}
+	d.NewExceptions, d.ResolvedExceptions = diffErrorItems(before.Exceptions, after.Exceptions)
+	d.NewErrors, d.ResolvedErrors = diffErrorItems(before.Errors, after.Errors)
+
+	return d
+}
+
+// HasRegressions returns true if the later run has any newly failing required endpoints (other than
+// members of passing anyOf groups), failures, exceptions, or errors that weren't present in the
+// earlier run
+func (d *Diff) HasRegressions() bool {
+	for _, change := range d.NewlyFailing {
+		if change.After.failing() {
+			return true
+		}
+	}
+	return len(d.NewFailures) > 0 || len(d.NewExceptions) > 0 || len(d.NewErrors) > 0
+}
+
+// Format can be used to retrieve a human-readable summary of the diff
+func (d *Diff) Format() string {
+	output := ""
+	output += formatChanges("newly failing endpoints:\n", d.NewlyFailing, func(c EndpointChange) string {
+		return fmt.Sprintf("%s (%s)", c.label(), c.After.Message)
+	})
+	output += formatChanges("newly passing endpoints:\n", d.NewlyPassing, func(c EndpointChange) string {
+		return c.label()
+	})
+	output += formatChanges("endpoints only tested in the earlier run:\n", d.Removed, func(c EndpointChange) string {
+		return c.label()
+	})
+	output += formatChanges("new failures:\n", d.NewFailures, errorItemMessage)
+	output += formatChanges("resolved failures:\n", d.ResolvedFailures, errorItemMessage)
+	output += formatChanges("new exceptions:\n", d.NewExceptions, errorItemMessage)
+	output += formatChanges("resolved exceptions:\n", d.ResolvedExceptions, errorItemMessage)
+	output += formatChanges("new errors:\n", d.NewErrors, errorItemMessage)
+	output += formatChanges("resolved errors:\n", d.ResolvedErrors, errorItemMessage)
+
+	stillFailing := 0
+	for _, c := range d.Unchanged {
+		if !c.After.Passed() {
+			stillFailing++
+		}
+	}
+	output += fmt.Sprintf("%d endpoint(s) unchanged (%d passing, %d failing)\n", len(d.Unchanged), len(d.Unchanged)-stillFailing, stillFailing)
+	return output
+}
+
+func formatChanges[T any](header string, items []T, describe func(T) string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	descriptions := make([]string, 0, len(items))
+	for _, item := range items {
+		descriptions = append(descriptions, describe(item))
+	}
+	return header + format(descriptions)
+}
+
+func errorItemMessage(item ErrorItem) string {
+	return item.Message
+}
+
+// errorItemKey identifies an error item across runs. Egress items are matched by code and URL,
+// ignoring the probe's description of the failure, so that a reworded message doesn't count as a
+// new problem. Other coded items are matched by code and normalized message, so that distinct
+// problems sharing a code are kept apart while changing numbers (e.g., durations) are ignored.
+// Items without a specific code are matched by their exact message
+type errorItemKey struct {
+	source    string
+	code      handledErrors.Code
+	egressURL string
+	message   string
+}
+
+// numberRegexp matches the numbers masked when normalizing messages
+var numberRegexp = regexp.MustCompile(`[0-9]+(\.[0-9]+)?`)
+
+func keyOfErrorItem(item ErrorItem) errorItemKey {
+	if item.Code == "" || item.Code == handledErrors.CodeInternal {
+		return errorItemKey{source: item.Source, message: item.Message}
+	}
+	if item.EgressURL == "" {
+		return errorItemKey{source: item.Source, code: item.Code, message: normalizeMessage(item.Message)}
+	}
+	// Endpoint failures record their URL followed by the probe's message, e.g., "https://quay.io:443
+	// (Connection timed out)", and URLs can't contain spaces
+	url, _, _ := strings.Cut(item.EgressURL, " ")
+	return errorItemKey{source: item.Source, code: item.Code, egressURL: url}
+}
+
+// normalizeMessage lowercases message and masks its numbers
+func normalizeMessage(message string) string {
+	return numberRegexp.ReplaceAllString(strings.ToLower(strings.TrimSpace(message)), "N")
+}
+
+// diffErrorItems returns the items only present in after (added) and those only present in before
+// (removed), each sorted by message. Items are matched by errorItemKey
+func diffErrorItems(before, after []ErrorItem) (added, removed []ErrorItem) {
+	added, removed = []ErrorItem{}, []ErrorItem{}
+	beforeKeys := map[errorItemKey]bool{}
+	for _, item := range before {
+		beforeKeys[keyOfErrorItem(item)] = true
+	}
+	afterKeys := map[errorItemKey]bool{}
+	for _, item := range after {
+		key := keyOfErrorItem(item)
+		afterKeys[key] = true
+		if !beforeKeys[key] {
+			added = append(added, item)
+			beforeKeys[key] = true // avoid reporting duplicates twice
+		}
+	}
+	for _, item := range before {
+		key := keyOfErrorItem(item)
+		if !afterKeys[key] {
+			removed = append(removed, item)
+			afterKeys[key] = true
+		}
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i].Message < added[j].Message })
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Message < removed[j].Message })
+	return added, removed
+}
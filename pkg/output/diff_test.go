@@ -0,0 +1,178 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+func TestDiffDocuments(t *testing.T) {
+	passed := func(url string) EndpointResult { return EndpointResult{URL: url, Status: EndpointPassed} }
+	failed := func(url string) EndpointResult {
+		return EndpointResult{URL: url, Status: EndpointFailed, Message: "Connection timed out"}
+	}
+	timeout := func(message string) error {
+		return handledErrors.NewGenericError(errors.New(message)).WithCode(handledErrors.CodeProbeTimeout)
+	}
+
+	tests := []struct {
+		name             string
+		before           *Output
+		after            *Output
+		wantNewlyFailing []string
+		wantNewlyPassing []string
+		wantUnchanged    int
+		wantRemoved      []string
+		wantNewFailures  int
+		wantNewErrors    int
+		wantResolvedExc  int
+		wantRegressions  bool
+	}{
+		{
+			name:          "identical runs",
+			before:        &Output{endpoints: []EndpointResult{passed("https://a:443"), failed("https://b:443")}},
+			after:         &Output{endpoints: []EndpointResult{passed("https://a:443"), failed("https://b:443")}},
+			wantUnchanged: 2,
+		},
+		{
+			name:             "firewall opened",
+			before:           &Output{endpoints: []EndpointResult{passed("https://a:443"), failed("https://b:443")}, exceptions: []error{errors.New("oops")}},
+			after:            &Output{endpoints: []EndpointResult{passed("https://a:443"), passed("https://b:443")}},
+			wantNewlyPassing: []string{"https://b:443"},
+			wantUnchanged:    1,
+			wantResolvedExc:  1,
+		},
+		{
+			name:             "firewall tightened",
+			before:           &Output{endpoints: []EndpointResult{passed("https://a:443"), passed("https://b:443")}},
+			after:            &Output{endpoints: []EndpointResult{failed("https://a:443"), failed("https://c:443")}},
+			wantNewlyFailing: []string{"https://a:443", "https://c:443"},
+			wantRemoved:      []string{"https://b:443"},
+			wantRegressions:  true,
+		},
+		{
+			name: "merged runs",
+			before: &Output{endpoints: []EndpointResult{
+				{URL: "https://a:443", Source: "subnet-1", Status: EndpointPassed},
+				{URL: "https://a:443", Source: "subnet-2", Status: EndpointPassed},
+			}},
+			after: &Output{endpoints: []EndpointResult{
+				{URL: "https://a:443", Source: "subnet-1", Status: EndpointPassed},
+				{URL: "https://a:443", Source: "subnet-2", Status: EndpointFailed},
+			}},
+			wantNewlyFailing: []string{"https://a:443"},
+			wantUnchanged:    1,
+			wantRegressions:  true,
+		},
+		{
+			name:            "new error",
+			before:          &Output{},
+			after:           &Output{errors: []error{errors.New("oops")}},
+			wantNewErrors:   1,
+			wantRegressions: true,
+		},
+		{
+			name:   "reworded coded exception",
+			before: &Output{exceptions: []error{timeout("probe timed out after 4m")}},
+			after:  &Output{exceptions: []error{timeout("probe timed out after 5m")}},
+		},
+		{
+			name:            "another coded exception",
+			before:          &Output{exceptions: []error{timeout("probe timed out after 4m")}},
+			after:           &Output{exceptions: []error{timeout("probe instance was terminated")}},
+			wantResolvedExc: 1,
+			wantRegressions: true,
+		},
+		{
+			name:            "reworded uncoded error",
+			before:          &Output{errors: []error{errors.New("oops")}},
+			after:           &Output{errors: []error{errors.New("oh no")}},
+			wantNewErrors:   1,
+			wantRegressions: true,
+		},
+		{
+			name:            "same code for another egress URL",
+			before:          &Output{failures: []error{handledErrors.NewEgressURLError("quay.io:443")}},
+			after:           &Output{failures: []error{handledErrors.NewEgressURLError("registry.redhat.io:443")}},
+			wantNewFailures: 1,
+			wantRegressions: true,
+		},
+		{
+			name: "same egress URL with another message",
+			before: &Output{failures: []error{
+				handledErrors.NewEgressURLErrorWithCode("https://quay.io:443 (Connection timed out after 2001 ms)", handledErrors.CodeEgressTimeout),
+			}},
+			after: &Output{failures: []error{
+				handledErrors.NewEgressURLErrorWithCode("https://quay.io:443 (Connection timed out after 3002 ms)", handledErrors.CodeEgressTimeout),
+			}},
+		},
+		{
+			name:            "legacy failures",
+			before:          &Output{},
+			after:           &Output{failures: []error{errors.New("quay.io:443")}},
+			wantNewFailures: 1,
+			wantRegressions: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DiffDocuments(tt.before.Document(), tt.after.Document())
+			assertURLs(t, "newly failing", d.NewlyFailing, tt.wantNewlyFailing)
+			assertURLs(t, "newly passing", d.NewlyPassing, tt.wantNewlyPassing)
+			assertURLs(t, "removed", d.Removed, tt.wantRemoved)
+			if len(d.Unchanged) != tt.wantUnchanged {
+				t.Errorf("expected %d unchanged endpoints, got %d", tt.wantUnchanged, len(d.Unchanged))
+			}
+			if len(d.NewFailures) != tt.wantNewFailures {
+				t.Errorf("expected %d new failures, got %d", tt.wantNewFailures, len(d.NewFailures))
+			}
+			if len(d.NewErrors) != tt.wantNewErrors {
+				t.Errorf("expected %d new errors, got %d", tt.wantNewErrors, len(d.NewErrors))
+			}
+			if len(d.ResolvedExceptions) != tt.wantResolvedExc {
+				t.Errorf("expected %d resolved exceptions, got %d", tt.wantResolvedExc, len(d.ResolvedExceptions))
+			}
+			if d.HasRegressions() != tt.wantRegressions {
+				t.Errorf("expected HasRegressions() = %v", tt.wantRegressions)
+			}
+		})
+	}
+}
+
+func assertURLs(t *testing.T, name string, changes []EndpointChange, want []string) {
+	t.Helper()
+	got := []string{}
+	for _, c := range changes {
+		got = append(got, c.URL)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("expected %s endpoints %v, got %v", name, want, got)
+	}
+}
+
+func TestReadDocument(t *testing.T) {
+	o := &Output{endpoints: []EndpointResult{{URL: "https://a:443", Status: EndpointPassed}}}
+	var buf bytes.Buffer
+	if _, err := o.WriteTo(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	doc, err := ReadDocument(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(doc.Endpoints) != 1 || doc.Endpoints[0].URL != "https://a:443" {
+		t.Errorf("unexpected endpoints: %+v", doc.Endpoints)
+	}
+
+	if _, err := ReadDocument(strings.NewReader(`{"schemaVersion": "v0"}`)); err == nil {
+		t.Errorf("expected error for unsupported schema version")
+	}
+	if _, err := ReadDocument(strings.NewReader(`Summary:`)); err == nil {
+		t.Errorf("expected error for non-JSON input")
+	}
+}
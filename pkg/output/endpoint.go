@@ -0,0 +1,432 @@
+package output
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+// EndpointStatus describes the outcome of a probe's attempt to reach an egress endpoint
+type EndpointStatus string
+
+const (
+	// EndpointPassed means the endpoint was reached and met all expectations
+	EndpointPassed EndpointStatus = "pass"
+	// EndpointFailed means the endpoint couldn't be reached or didn't meet expectations
+	EndpointFailed EndpointStatus = "fail"
+)
+
+// FailureCategory broadly classifies why an egress endpoint failed verification
+type FailureCategory string
+
+const (
+	// FailureCategoryNone is used for endpoints that passed
+	FailureCategoryNone FailureCategory = ""
+	// FailureCategoryUnreachable means the probe couldn't connect to the endpoint for a reason not
+	// covered by a more specific category
+	FailureCategoryUnreachable FailureCategory = "unreachable"
+	// FailureCategoryDNS means the endpoint's hostname couldn't be resolved
+	FailureCategoryDNS FailureCategory = "dns-resolution"
+	// FailureCategoryConnectionRefused means the connection to the endpoint was actively refused or
+	// couldn't be established (e.g., no route to host)
+	FailureCategoryConnectionRefused FailureCategory = "connection-refused"
+	// FailureCategoryTimeout means the request timed out, typically because a firewall silently
+	// dropped the traffic
+	FailureCategoryTimeout FailureCategory = "timeout"
+	// FailureCategoryConnectionReset means the connection was established but then closed or reset
+	// before a response was received
+	FailureCategoryConnectionReset FailureCategory = "connection-reset"
+	// FailureCategoryTLSHandshake means the TLS handshake with the endpoint failed
+	FailureCategoryTLSHandshake FailureCategory = "tls-handshake"
+	// FailureCategoryCertificate means the endpoint's TLS certificate couldn't be verified, often
+	// because a TLS-inspecting proxy re-signed it with an untrusted CA
+	FailureCategoryCertificate FailureCategory = "certificate"
+	// FailureCategoryProxy means a proxy rejected the request or couldn't be reached
+	FailureCategoryProxy FailureCategory = "proxy"
+	// FailureCategoryNonPrivateAddress means the endpoint was reached, but resolved to a public
+	// address where a private one was required (e.g., on zero-egress platforms)
+	FailureCategoryNonPrivateAddress FailureCategory = "non-private-address"
+	// FailureCategoryHTTPStatus means the endpoint was reached, but responded with an HTTP status
+	// code its egress list doesn't expect
+	FailureCategoryHTTPStatus FailureCategory = "unexpected-status"
+	// FailureCategoryUnexpectedAddress means the endpoint was reached, but at an address outside
+	// the ranges its egress list expects
+	FailureCategoryUnexpectedAddress FailureCategory = "unexpected-address"
+)
+
+// EndpointTimings holds the durations (in seconds, as reported by curl's --write-out) of each
+// phase of a request, measured from the start of the request
+type EndpointTimings struct {
+	NameLookup    float64 `json:"nameLookup"`
+	Connect       float64 `json:"connect"`
+	AppConnect    float64 `json:"appConnect"`
+	PreTransfer   float64 `json:"preTransfer"`
+	StartTransfer float64 `json:"startTransfer"`
+	Total         float64 `json:"total"`
+}
+
+// EndpointInfo documents an egress endpoint, as described by the egress list it came from
+type EndpointInfo struct {
+	// Category broadly groups endpoints by purpose, e.g., "registry" or "telemetry"
+	Category string `json:"category,omitempty"`
+	// Owner is the team or component that needs the endpoint
+	Owner       string `json:"owner,omitempty"`
+	Description string `json:"description,omitempty"`
+	// DocsURL links to documentation explaining why the endpoint is needed
+	DocsURL string `json:"docsUrl,omitempty"`
+}
+
+// EndpointResult records everything a probe learned about a single egress endpoint, whether or not
+// the probe was able to reach it
+type EndpointResult struct {
+	// URL is the URL the probe attempted to reach, e.g., "https://quay.io:443"
+	URL    string `json:"url"`
+	Host   string `json:"host"`
+	Port   int    `json:"port"`
+	Scheme string `json:"scheme,omitempty"`
+	// RemoteIP is the address the endpoint's host resolved to, if any
+	RemoteIP     string          `json:"remoteIp,omitempty"`
+	CurlExitCode int             `json:"curlExitCode"`
+	HTTPCode     int             `json:"httpCode,omitempty"`
+	Timings      EndpointTimings `json:"timings"`
+	// Attempts is the number of times the probe tried to reach the endpoint, including retries.
+	// Zero if the probe doesn't report it
+	Attempts int            `json:"attempts,omitempty"`
+	Status   EndpointStatus `json:"status"`
+	// Category explains why a failed endpoint failed. Empty for passed endpoints
+	Category FailureCategory `json:"category,omitempty"`
+	// Message is a human-readable description of the failure (e.g., curl's error message)
+	Message string `json:"message,omitempty"`
+	// Source labels the run the result came from (e.g., a subnet ID) in merged outputs
+	Source string `json:"source,omitempty"`
+	// Optional is true if the egress list marked the endpoint as not required. Optional endpoints
+	// that fail are reported as warnings rather than failures
+	Optional bool `json:"optional,omitempty"`
+	// Info documents the endpoint, if its egress list did
+	Info *EndpointInfo `json:"info,omitempty"`
+	// Wildcard is the wildcard host (e.g., "*.apps.example.com") the endpoint's host is a sample of,
+	// if any
+	Wildcard string `json:"wildcard,omitempty"`
+	// AnyOf names the group of alternative endpoints the endpoint belongs to, if any. A group passes
+	// as long as any of its members do
+	AnyOf string `json:"anyOf,omitempty"`
+	// SatisfiedBy is the URL of the passing member of the endpoint's AnyOf group, if the endpoint
+	// failed but its group passed. Such endpoints aren't reported as failures or warnings
+	SatisfiedBy string `json:"satisfiedBy,omitempty"`
+}
+
+// GroupResult summarizes the results of the members of an anyOf group of alternative endpoints on
+// a single port. A group passes on a port if any of its members passed on that port
+type GroupResult struct {
+	AnyOf  string         `json:"anyOf"`
+	Port   int            `json:"port"`
+	Status EndpointStatus `json:"status"`
+	// Source labels the run the result came from (e.g., a subnet ID) in merged outputs
+	Source string `json:"source,omitempty"`
+	// Optional is true if none of the group's members are required
+	Optional bool `json:"optional,omitempty"`
+	// MemberURLs lists the URL of every member tested, and PassedURLs those that passed
+	MemberURLs []string `json:"memberUrls"`
+	PassedURLs []string `json:"passedUrls"`
+}
+
+// Passed returns true if any member of the group passed verification
+func (r GroupResult) Passed() bool {
+	return r.Status == EndpointPassed
+}
+
+// WildcardResult summarizes the results of the sample hosts probed for a wildcard egress list entry
+// on a single port. A wildcard fails if any of its samples failed
+type WildcardResult struct {
+	// Wildcard is the wildcard host, e.g., "*.apps.example.com"
+	Wildcard string         `json:"wildcard"`
+	Port     int            `json:"port"`
+	Status   EndpointStatus `json:"status"`
+	// Source labels the run the result came from (e.g., a subnet ID) in merged outputs
+	Source   string `json:"source,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+	// SampleURLs lists the URL of every sample probed for the wildcard, and FailedURLs those that
+	// failed
+	SampleURLs []string `json:"sampleUrls"`
+	FailedURLs []string `json:"failedUrls"`
+}
+
+// Passed returns true if every sample of the wildcard passed verification
+func (r WildcardResult) Passed() bool {
+	return r.Status == EndpointPassed
+}
+
+// HostPort returns the endpoint's host and port joined as "host:port"
+func (r EndpointResult) HostPort() string {
+	return net.JoinHostPort(r.Host, strconv.Itoa(r.Port))
+}
+
+// Passed returns true if the endpoint passed verification
+func (r EndpointResult) Passed() bool {
+	return r.Status == EndpointPassed
+}
+
+// Warning returns true if the endpoint failed verification but isn't required, and no other member
+// of its anyOf group passed
+func (r EndpointResult) Warning() bool {
+	return !r.Passed() && r.Optional && r.SatisfiedBy == ""
+}
+
+// failing returns true if the endpoint failed verification, is required, and no other member of its
+// anyOf group passed
+func (r EndpointResult) failing() bool {
+	return !r.Passed() && !r.Optional && r.SatisfiedBy == ""
+}
+
+// egressError converts a failed EndpointResult into the egressURL error reported in the output's
+// failures, in the same "<url> (<message>)" format historically produced by the curl probe
+func (r EndpointResult) egressError() error {
+	code := r.Category.Code()
+	if code == "" {
+		// Uncategorized failures, e.g., from probes that don't classify them
+		code = handledErrors.CodeEgressBlocked
+	}
+	err := handledErrors.NewEgressURLErrorWithCode(fmt.Sprintf("%s (%s)", r.URL, r.Message), code)
+	if r.Source != "" {
+		return &sourcedError{source: r.Source, err: err}
+	}
+	return err
+}
+
+// AddEndpointResult records the result of a probe's attempt to reach an egress endpoint. Failed
+// endpoints are automatically reported as egress failures
+func (o *Output) AddEndpointResult(result EndpointResult) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.endpoints = append(o.endpoints, result)
+}
+
+// AnnotateEndpoints calls annotate on each recorded endpoint result, allowing callers to attach
+// information the probe doesn't know about, e.g., whether the egress list marked the endpoint as
+// optional. Failed members of anyOf groups are then marked as satisfied by a member that passed on
+// the same port, if any
+func (o *Output) AnnotateEndpoints(annotate func(result *EndpointResult)) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := range o.endpoints {
+		annotate(&o.endpoints[i])
+	}
+
+	passed := map[groupKey]string{}
+	for _, result := range o.endpoints {
+		key := groupKey{result.AnyOf, result.Port, result.Source}
+		if result.AnyOf != "" && result.Passed() && passed[key] == "" {
+			passed[key] = result.URL
+		}
+	}
+	for i := range o.endpoints {
+		o.endpoints[i].SatisfiedBy = ""
+		if !o.endpoints[i].Passed() {
+			o.endpoints[i].SatisfiedBy = passed[groupKey{o.endpoints[i].AnyOf, o.endpoints[i].Port, o.endpoints[i].Source}]
+		}
+	}
+}
+
+// groupKey identifies an anyOf group on a port within a run. Members only stand in for each other
+// on the same port, e.g., a mirror reachable on port 80 doesn't make up for another one blocked on 443
+type groupKey struct {
+	anyOf  string
+	port   int
+	source string
+}
+
+// EndpointResults returns the results of every egress endpoint recorded by the probe, in the order
+// they were recorded
+func (o *Output) EndpointResults() []EndpointResult {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return append([]EndpointResult{}, o.endpoints...)
+}
+
+// WildcardResults groups the recorded results of wildcard samples by wildcard, port and source, in
+// the order they were first recorded
+func (o *Output) WildcardResults() []WildcardResult {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return wildcardResults(o.endpoints)
+}
+
+func wildcardResults(endpoints []EndpointResult) []WildcardResult {
+	type wildcardKey struct {
+		wildcard string
+		port     int
+		source   string
+	}
+	results := []WildcardResult{}
+	indexes := map[wildcardKey]int{}
+	for _, endpoint := range endpoints {
+		if endpoint.Wildcard == "" {
+			continue
+		}
+		key := wildcardKey{endpoint.Wildcard, endpoint.Port, endpoint.Source}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(results)
+			indexes[key] = i
+			results = append(results, WildcardResult{
+				Wildcard:   endpoint.Wildcard,
+				Port:       endpoint.Port,
+				Status:     EndpointPassed,
+				Source:     endpoint.Source,
+				Optional:   endpoint.Optional,
+				SampleURLs: []string{},
+				FailedURLs: []string{},
+			})
+		}
+		results[i].SampleURLs = append(results[i].SampleURLs, endpoint.URL)
+		if !endpoint.Passed() {
+			results[i].Status = EndpointFailed
+			results[i].FailedURLs = append(results[i].FailedURLs, endpoint.URL)
+		}
+	}
+	return results
+}
+
+// GroupResults groups the recorded results of anyOf group members by group and source, in the
+// order they were first recorded
+func (o *Output) GroupResults() []GroupResult {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return groupResults(o.endpoints)
+}
+
+func groupResults(endpoints []EndpointResult) []GroupResult {
+	results := []GroupResult{}
+	indexes := map[groupKey]int{}
+	for _, endpoint := range endpoints {
+		if endpoint.AnyOf == "" {
+			continue
+		}
+		key := groupKey{endpoint.AnyOf, endpoint.Port, endpoint.Source}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(results)
+			indexes[key] = i
+			results = append(results, GroupResult{
+				AnyOf:      endpoint.AnyOf,
+				Port:       endpoint.Port,
+				Status:     EndpointFailed,
+				Source:     endpoint.Source,
+				Optional:   true,
+				MemberURLs: []string{},
+				PassedURLs: []string{},
+			})
+		}
+		results[i].MemberURLs = append(results[i].MemberURLs, endpoint.URL)
+		results[i].Optional = results[i].Optional && endpoint.Optional
+		if endpoint.Passed() {
+			results[i].Status = EndpointPassed
+			results[i].PassedURLs = append(results[i].PassedURLs, endpoint.URL)
+		}
+	}
+	return results
+}
+
+// PassedEndpoints returns the results of every egress endpoint that passed verification
+func (o *Output) PassedEndpoints() []EndpointResult {
+	return o.filterEndpoints(EndpointPassed)
+}
+
+// FailedEndpoints returns the results of every egress endpoint that failed verification
+func (o *Output) FailedEndpoints() []EndpointResult {
+	return o.filterEndpoints(EndpointFailed)
+}
+
+// LookupEndpoint returns the first recorded result for the given host and port, if any
+func (o *Output) LookupEndpoint(host string, port int) (EndpointResult, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	for _, result := range o.endpoints {
+		if result.Host == host && result.Port == port {
+			return result, true
+		}
+	}
+	return EndpointResult{}, false
+}
+
+func (o *Output) filterEndpoints(status EndpointStatus) []EndpointResult {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	results := []EndpointResult{}
+	for _, result := range o.endpoints {
+		if result.Status == status {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// allFailures returns the failures added directly to the output followed by an egressURL error for
+// each failed required endpoint (unless another member of its anyOf group passed). It doesn't lock
+// the output, so it must only be called on a snapshot
+func (o *Output) allFailures() []error {
+	failures := append([]error{}, o.failures...)
+	for _, result := range o.endpoints {
+		if result.failing() {
+			failures = append(failures, result.egressError())
+		}
+	}
+	return failures
+}
+
+// allWarnings returns an egressURL error for each failed optional endpoint. It doesn't lock the
+// output, so it must only be called on a snapshot
+func (o *Output) allWarnings() []error {
+	warnings := []error{}
+	for _, result := range o.endpoints {
+		if result.Warning() {
+			warnings = append(warnings, result.egressError())
+		}
+	}
+	return warnings
+}
+
+// Warnings returns an egressURL error for each optional endpoint that failed verification.
+// Warnings don't affect IsSuccessful()
+func (o *Output) Warnings() []error {
+	return o.snapshot().allWarnings()
+}
+
+// Code returns the error code reported for failures of this category. Empty for
+// FailureCategoryNone
+func (c FailureCategory) Code() handledErrors.Code {
+	switch c {
+	case FailureCategoryNone:
+		return ""
+	case FailureCategoryNonPrivateAddress:
+		return handledErrors.CodeEgressNonPrivate
+	case FailureCategoryDNS:
+		return handledErrors.CodeEgressDNS
+	case FailureCategoryConnectionRefused:
+		return handledErrors.CodeEgressRefused
+	case FailureCategoryTimeout:
+		return handledErrors.CodeEgressTimeout
+	case FailureCategoryConnectionReset:
+		return handledErrors.CodeEgressReset
+	case FailureCategoryTLSHandshake:
+		return handledErrors.CodeEgressTLS
+	case FailureCategoryCertificate:
+		return handledErrors.CodeEgressCertificate
+	case FailureCategoryProxy:
+		return handledErrors.CodeEgressProxy
+	case FailureCategoryHTTPStatus:
+		return handledErrors.CodeEgressHTTPStatus
+	case FailureCategoryUnexpectedAddress:
+		return handledErrors.CodeEgressAddress
+	default:
+		return handledErrors.CodeEgressBlocked
+	}
+}
+
+// Remediation returns a short suggestion for resolving failures of this category. Empty for
+// FailureCategoryNone
+func (c FailureCategory) Remediation() string {
+	return c.Code().Remediation()
+}
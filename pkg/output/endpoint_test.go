@@ -0,0 +1,164 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	nverr "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+func TestOutput_EndpointResults(t *testing.T) {
+	o := &Output{}
+	o.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Host: "quay.io", Port: 443, Status: EndpointPassed})
+	o.AddEndpointResult(EndpointResult{
+		URL:      "https://www.example.com:443",
+		Host:     "www.example.com",
+		Port:     443,
+		Status:   EndpointFailed,
+		Category: FailureCategoryUnreachable,
+		Message:  "Connection timed out",
+	})
+
+	if got := len(o.EndpointResults()); got != 2 {
+		t.Errorf("expected 2 endpoint results, got %d", got)
+	}
+	if got := o.PassedEndpoints(); len(got) != 1 || got[0].Host != "quay.io" {
+		t.Errorf("unexpected passed endpoints: %+v", got)
+	}
+	if got := o.FailedEndpoints(); len(got) != 1 || got[0].Host != "www.example.com" {
+		t.Errorf("unexpected failed endpoints: %+v", got)
+	}
+	if _, found := o.LookupEndpoint("quay.io", 80); found {
+		t.Errorf("unexpectedly found result for quay.io:80")
+	}
+	if got, found := o.LookupEndpoint("www.example.com", 443); !found || got.HostPort() != "www.example.com:443" {
+		t.Errorf("expected to find www.example.com:443, got %+v", got)
+	}
+
+	if o.IsSuccessful() {
+		t.Errorf("expected output with a failed endpoint to be unsuccessful")
+	}
+	if egressErrs := o.GetEgressURLFailures(); len(egressErrs) != 1 || egressErrs[0].Code() != nverr.CodeEgressBlocked {
+		t.Errorf("expected a single %v failure, got %v", nverr.CodeEgressBlocked, egressErrs)
+	}
+	failures, _, _ := o.Parse()
+	if len(failures) != 1 || failures[0].Error() != "egressURL error: https://www.example.com:443 (Connection timed out)" {
+		t.Errorf("unexpected failures: %v", failures)
+	}
+}
+
+func TestOutput_OptionalEndpoints(t *testing.T) {
+	o := &Output{}
+	o.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Host: "quay.io", Port: 443, Status: EndpointPassed})
+	o.AddEndpointResult(EndpointResult{
+		URL:      "https://console.redhat.com:443",
+		Host:     "console.redhat.com",
+		Port:     443,
+		Status:   EndpointFailed,
+		Category: FailureCategoryTimeout,
+		Message:  "Connection timed out",
+	})
+	o.AnnotateEndpoints(func(result *EndpointResult) {
+		if result.Host == "console.redhat.com" {
+			result.Optional = true
+			result.Info = &EndpointInfo{Category: "telemetry"}
+		}
+	})
+
+	if !o.IsSuccessful() {
+		t.Errorf("expected output with only a failed optional endpoint to be successful")
+	}
+	if failures, _, _ := o.Parse(); len(failures) != 0 {
+		t.Errorf("expected no failures, got %v", failures)
+	}
+	warnings := o.Warnings()
+	if len(warnings) != 1 || warnings[0].Error() != "egressURL error: https://console.redhat.com:443 (Connection timed out)" {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if got, _ := o.LookupEndpoint("console.redhat.com", 443); !got.Warning() || got.Info.Category != "telemetry" {
+		t.Errorf("expected annotated endpoint, got %+v", got)
+	}
+}
+
+func TestOutput_WildcardResults(t *testing.T) {
+	o := &Output{}
+	o.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Host: "quay.io", Port: 443, Status: EndpointPassed})
+	o.AddEndpointResult(EndpointResult{URL: "https://a.s3.amazonaws.com:443", Port: 443, Status: EndpointPassed, Wildcard: "*.s3.amazonaws.com"})
+	o.AddEndpointResult(EndpointResult{URL: "https://b.s3.amazonaws.com:443", Port: 443, Status: EndpointFailed, Wildcard: "*.s3.amazonaws.com"})
+	o.AddEndpointResult(EndpointResult{URL: "http://a.s3.amazonaws.com:80", Port: 80, Status: EndpointPassed, Wildcard: "*.s3.amazonaws.com"})
+	o.AddEndpointResult(EndpointResult{URL: "https://a.s3.amazonaws.com:443", Port: 443, Status: EndpointPassed, Wildcard: "*.s3.amazonaws.com", Source: "subnet-2"})
+
+	got := o.WildcardResults()
+	if len(got) != 3 {
+		t.Fatalf("expected 3 wildcard results, got %+v", got)
+	}
+	if got[0].Passed() || len(got[0].SampleURLs) != 2 || len(got[0].FailedURLs) != 1 || got[0].FailedURLs[0] != "https://b.s3.amazonaws.com:443" {
+		t.Errorf("expected *.s3.amazonaws.com:443 to fail because of its second sample, got %+v", got[0])
+	}
+	if !got[1].Passed() || got[1].Port != 80 {
+		t.Errorf("expected *.s3.amazonaws.com:80 to pass, got %+v", got[1])
+	}
+	if !got[2].Passed() || got[2].Source != "subnet-2" {
+		t.Errorf("expected *.s3.amazonaws.com:443 to pass in subnet-2, got %+v", got[2])
+	}
+}
+
+func TestOutput_GroupResults(t *testing.T) {
+	o := &Output{}
+	o.AddEndpointResult(EndpointResult{URL: "https://mirror-a.example.com:443", Host: "mirror-a.example.com", Port: 443, Status: EndpointFailed, Message: "Connection timed out"})
+	o.AddEndpointResult(EndpointResult{URL: "https://mirror-b.example.com:443", Host: "mirror-b.example.com", Port: 443, Status: EndpointPassed})
+	o.AddEndpointResult(EndpointResult{URL: "https://cdn-a.example.com:443", Host: "cdn-a.example.com", Port: 443, Status: EndpointFailed, Message: "Connection timed out"})
+	o.AddEndpointResult(EndpointResult{URL: "https://cdn-b.example.com:443", Host: "cdn-b.example.com", Port: 443, Status: EndpointFailed, Message: "Connection refused"})
+	o.AnnotateEndpoints(func(result *EndpointResult) {
+		if strings.HasPrefix(result.Host, "mirror-") {
+			result.AnyOf = "mirrors"
+		} else {
+			result.AnyOf = "cdns"
+		}
+	})
+
+	if got, _ := o.LookupEndpoint("mirror-a.example.com", 443); got.SatisfiedBy != "https://mirror-b.example.com:443" || got.Warning() {
+		t.Errorf("expected mirror-a to be satisfied by mirror-b, got %+v", got)
+	}
+	failures, _, _ := o.Parse()
+	if len(failures) != 2 || o.IsSuccessful() {
+		t.Errorf("expected only the cdns group members to fail, got %v", failures)
+	}
+
+	got := o.GroupResults()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 group results, got %+v", got)
+	}
+	if !got[0].Passed() || got[0].AnyOf != "mirrors" || len(got[0].MemberURLs) != 2 || len(got[0].PassedURLs) != 1 {
+		t.Errorf("expected the mirrors group to pass through mirror-b, got %+v", got[0])
+	}
+	if got[1].Passed() || got[1].AnyOf != "cdns" || len(got[1].PassedURLs) != 0 {
+		t.Errorf("expected the cdns group to fail, got %+v", got[1])
+	}
+}
+
+func TestOutput_GroupResultsPerPort(t *testing.T) {
+	o := &Output{}
+	o.AddEndpointResult(EndpointResult{URL: "https://mirror-a.example.com:443", Host: "mirror-a.example.com", Port: 443, Status: EndpointPassed})
+	o.AddEndpointResult(EndpointResult{URL: "http://mirror-a.example.com:80", Host: "mirror-a.example.com", Port: 80, Status: EndpointFailed, Message: "Connection timed out"})
+	o.AddEndpointResult(EndpointResult{URL: "https://mirror-b.example.com:443", Host: "mirror-b.example.com", Port: 443, Status: EndpointFailed, Message: "Connection timed out"})
+	o.AnnotateEndpoints(func(result *EndpointResult) { result.AnyOf = "mirrors" })
+
+	if got, _ := o.LookupEndpoint("mirror-b.example.com", 443); got.SatisfiedBy != "https://mirror-a.example.com:443" {
+		t.Errorf("expected mirror-b to be satisfied by mirror-a on port 443, got %+v", got)
+	}
+	if got, _ := o.LookupEndpoint("mirror-a.example.com", 80); got.SatisfiedBy != "" {
+		t.Errorf("expected no member to satisfy the group on port 80, got %+v", got)
+	}
+
+	got := o.GroupResults()
+	if len(got) != 2 {
+		t.Fatalf("expected a group result per port, got %+v", got)
+	}
+	if !got[0].Passed() || got[0].Port != 443 {
+		t.Errorf("expected the mirrors group to pass on port 443, got %+v", got[0])
+	}
+	if got[1].Passed() || got[1].Port != 80 {
+		t.Errorf("expected the mirrors group to fail on port 80, got %+v", got[1])
+	}
+}
@@ -0,0 +1,110 @@
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+	"github.com/openshift/osd-network-verifier/version"
+)
+
+// JSONSchemaVersion identifies the layout of the document produced by Output.MarshalJSON. It is
+// only bumped when fields are removed or change meaning; new optional fields may be added to the
+// current version at any time. See docs/output.md for the full schema
+const JSONSchemaVersion = "v1"
+
+// Document is the machine-readable representation of an Output, as produced by
+// Output.MarshalJSON and Output.WriteTo
+type Document struct {
+	SchemaVersion   string      `json:"schemaVersion"`
+	VerifierVersion string      `json:"verifierVersion,omitempty"`
+	Successful      bool        `json:"successful"`
+	Metadata        RunMetadata `json:"metadata"`
+	Failures        []ErrorItem `json:"failures"`
+	// Warnings holds failed optional endpoints, which don't affect Successful
+	Warnings   []ErrorItem `json:"warnings"`
+	Exceptions []ErrorItem `json:"exceptions"`
+	Errors     []ErrorItem `json:"errors"`
+	// Endpoints holds the result of every egress endpoint tested by the probe, including those
+	// that passed. Empty for probes that only report failures (e.g., legacy.Probe)
+	Endpoints []EndpointResult `json:"endpoints"`
+	// Wildcards groups the results of Endpoints that are samples of a wildcard egress list entry
+	Wildcards []WildcardResult `json:"wildcards"`
+	// Groups summarizes the results of Endpoints that are members of an anyOf group
+	Groups    []GroupResult `json:"groups"`
+	DebugLogs []string      `json:"debugLogs"`
+}
+
+// ErrorItem is the machine-readable representation of a single failure, exception, or error
+type ErrorItem struct {
+	Message   string `json:"message"`
+	EgressURL string `json:"egressUrl,omitempty"`
+	// Code, Category, and Remediation classify the problem. See pkg/errors for possible values
+	Code        handledErrors.Code     `json:"code"`
+	Category    handledErrors.Category `json:"category"`
+	Remediation string                 `json:"remediation,omitempty"`
+	// Source labels the run the item came from (e.g., a subnet ID) in merged outputs
+	Source string `json:"source,omitempty"`
+}
+
+// Document converts the output into its machine-readable representation. Slices in the returned
+// Document are never nil, so that they serialize as empty JSON arrays rather than null
+func (o *Output) Document() *Document {
+	s := o.snapshot()
+	return &Document{
+		SchemaVersion:   JSONSchemaVersion,
+		VerifierVersion: version.Version,
+		Successful:      s.IsSuccessful(),
+		Metadata:        s.metadata,
+		Failures:        toErrorItems(s.allFailures()),
+		Warnings:        toErrorItems(s.allWarnings()),
+		Exceptions:      toErrorItems(s.exceptions),
+		Errors:          toErrorItems(s.errors),
+		Endpoints:       s.endpoints,
+		Wildcards:       wildcardResults(s.endpoints),
+		Groups:          groupResults(s.endpoints),
+		DebugLogs:       s.debugLogs,
+	}
+}
+
+// MarshalJSON serializes the output according to the schema identified by JSONSchemaVersion
+func (o *Output) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.Document())
+}
+
+// WriteTo writes the output to w as an indented JSON document followed by a newline. It
+// implements io.WriterTo
+func (o *Output) WriteTo(w io.Writer) (int64, error) {
+	b, err := json.MarshalIndent(o.Document(), "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(append(b, '\n'))
+	return int64(n), err
+}
+
+func toErrorItems(errs []error) []ErrorItem {
+	items := make([]ErrorItem, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		item := ErrorItem{Message: err.Error()}
+		var nve *handledErrors.GenericError
+		if !errors.As(err, &nve) {
+			// Classify errors not built by pkg/errors the same way as NewGenericError would
+			nve = handledErrors.NewGenericError(err)
+		}
+		item.EgressURL = nve.EgressURL()
+		item.Code = nve.Code()
+		item.Category = nve.Category()
+		item.Remediation = nve.Remediation()
+		var se *sourcedError
+		if errors.As(err, &se) {
+			item.Source = se.source
+		}
+		items = append(items, item)
+	}
+	return items
+}
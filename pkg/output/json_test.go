@@ -0,0 +1,202 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	nverr "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+func TestOutput_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		o    *Output
+		want Document
+	}{
+		{
+			name: "empty output",
+			o:    &Output{},
+			want: Document{
+				SchemaVersion: JSONSchemaVersion,
+				Successful:    true,
+				Failures:      []ErrorItem{},
+				Warnings:      []ErrorItem{},
+				Exceptions:    []ErrorItem{},
+				Errors:        []ErrorItem{},
+				Endpoints:     []EndpointResult{},
+				Wildcards:     []WildcardResult{},
+				Groups:        []GroupResult{},
+				DebugLogs:     []string{},
+			},
+		},
+		{
+			name: "failures, exceptions, errors and metadata",
+			o: &Output{
+				debugLogs:  []string{"hello"},
+				failures:   []error{nverr.NewEgressURLError("www.example.com:443")},
+				exceptions: []error{errors.New("oops")},
+				errors:     []error{nverr.NewGenericError(errors.New("idk"))},
+				metadata: RunMetadata{
+					Platform: "aws-classic",
+					Region:   "us-east-1",
+					SubnetID: "subnet-123",
+				},
+			},
+			want: Document{
+				SchemaVersion: JSONSchemaVersion,
+				Successful:    false,
+				Metadata: RunMetadata{
+					Platform: "aws-classic",
+					Region:   "us-east-1",
+					SubnetID: "subnet-123",
+				},
+				Failures: []ErrorItem{
+					{
+						Message:     "egressURL error: www.example.com:443",
+						EgressURL:   "www.example.com:443",
+						Code:        nverr.CodeEgressBlocked,
+						Category:    nverr.CategoryEgress,
+						Remediation: nverr.CodeEgressBlocked.Remediation(),
+					},
+				},
+				Warnings:   []ErrorItem{},
+				Exceptions: []ErrorItem{{Message: "oops", Code: nverr.CodeInternal, Category: nverr.CategoryInternal}},
+				Errors:     []ErrorItem{{Message: "network verifier error: idk", Code: nverr.CodeInternal, Category: nverr.CategoryInternal}},
+				Endpoints:  []EndpointResult{},
+				Wildcards:  []WildcardResult{},
+				Groups:     []GroupResult{},
+				DebugLogs:  []string{"hello"},
+			},
+		},
+		{
+			name: "failed optional endpoint",
+			o: &Output{
+				endpoints: []EndpointResult{
+					{URL: "https://example.com:443", Status: EndpointFailed, Category: FailureCategoryTimeout, Message: "timed out", Optional: true},
+				},
+			},
+			want: Document{
+				SchemaVersion: JSONSchemaVersion,
+				Successful:    true,
+				Failures:      []ErrorItem{},
+				Warnings: []ErrorItem{
+					{
+						Message:     "egressURL error: https://example.com:443 (timed out)",
+						EgressURL:   "https://example.com:443 (timed out)",
+						Code:        nverr.CodeEgressTimeout,
+						Category:    nverr.CategoryEgress,
+						Remediation: nverr.CodeEgressTimeout.Remediation(),
+					},
+				},
+				Exceptions: []ErrorItem{},
+				Errors:     []ErrorItem{},
+				Endpoints: []EndpointResult{
+					{URL: "https://example.com:443", Status: EndpointFailed, Category: FailureCategoryTimeout, Message: "timed out", Optional: true},
+				},
+				Wildcards: []WildcardResult{},
+				Groups:    []GroupResult{},
+				DebugLogs: []string{},
+			},
+		},
+		{
+			name: "wildcard samples",
+			o: &Output{
+				endpoints: []EndpointResult{
+					{URL: "https://a.example.com:443", Port: 443, Status: EndpointPassed, Wildcard: "*.example.com"},
+					{URL: "https://b.example.com:443", Port: 443, Status: EndpointPassed, Wildcard: "*.example.com"},
+				},
+			},
+			want: Document{
+				SchemaVersion: JSONSchemaVersion,
+				Successful:    true,
+				Failures:      []ErrorItem{},
+				Warnings:      []ErrorItem{},
+				Exceptions:    []ErrorItem{},
+				Errors:        []ErrorItem{},
+				Endpoints: []EndpointResult{
+					{URL: "https://a.example.com:443", Port: 443, Status: EndpointPassed, Wildcard: "*.example.com"},
+					{URL: "https://b.example.com:443", Port: 443, Status: EndpointPassed, Wildcard: "*.example.com"},
+				},
+				Wildcards: []WildcardResult{
+					{
+						Wildcard:   "*.example.com",
+						Port:       443,
+						Status:     EndpointPassed,
+						SampleURLs: []string{"https://a.example.com:443", "https://b.example.com:443"},
+						FailedURLs: []string{},
+					},
+				},
+				Groups:    []GroupResult{},
+				DebugLogs: []string{},
+			},
+		},
+		{
+			name: "anyOf group satisfied by a mirror",
+			o: &Output{
+				endpoints: []EndpointResult{
+					{URL: "https://mirror-a.example.com:443", Port: 443, Status: EndpointFailed, Category: FailureCategoryTimeout, Message: "timed out", AnyOf: "mirrors", SatisfiedBy: "https://mirror-b.example.com:443"},
+					{URL: "https://mirror-b.example.com:443", Port: 443, Status: EndpointPassed, AnyOf: "mirrors"},
+				},
+			},
+			want: Document{
+				SchemaVersion: JSONSchemaVersion,
+				Successful:    true,
+				Failures:      []ErrorItem{},
+				Warnings:      []ErrorItem{},
+				Exceptions:    []ErrorItem{},
+				Errors:        []ErrorItem{},
+				Endpoints: []EndpointResult{
+					{URL: "https://mirror-a.example.com:443", Port: 443, Status: EndpointFailed, Category: FailureCategoryTimeout, Message: "timed out", AnyOf: "mirrors", SatisfiedBy: "https://mirror-b.example.com:443"},
+					{URL: "https://mirror-b.example.com:443", Port: 443, Status: EndpointPassed, AnyOf: "mirrors"},
+				},
+				Wildcards: []WildcardResult{},
+				Groups: []GroupResult{
+					{
+						AnyOf:      "mirrors",
+						Port:       443,
+						Status:     EndpointPassed,
+						MemberURLs: []string{"https://mirror-a.example.com:443", "https://mirror-b.example.com:443"},
+						PassedURLs: []string{"https://mirror-b.example.com:443"},
+					},
+				},
+				DebugLogs: []string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.o)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var got Document
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("failed to unmarshal %s: %s", b, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutput_WriteTo(t *testing.T) {
+	o := &Output{}
+	o.AddException(errors.New("oops"))
+
+	var buf bytes.Buffer
+	n, err := o.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("reported %d bytes written, buffer holds %d", n, buf.Len())
+	}
+	if !json.Valid(buf.Bytes()) {
+		t.Errorf("WriteTo produced invalid JSON: %s", buf.String())
+	}
+}
@@ -0,0 +1,206 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// junitVerifierSuite is the name of the JUnit test suite holding the output's exceptions and
+// errors, which aren't tied to any specific check but still need to surface in CI
+const junitVerifierSuite = "verifier"
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr,omitempty"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr,omitempty"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the output to w as a JUnit XML report. Each egress endpoint result becomes a
+// test case in the "egress" suite, and each recorded Check becomes a test case within a test suite
+// named after Check.Suite. Exceptions and errors are reported as errored test cases in an
+// additional "verifier" suite so that they aren't silently dropped by CI systems
+func (o *Output) WriteJUnit(w io.Writer) error {
+	report := junitTestSuites{Name: "osd-network-verifier"}
+	o = o.snapshot()
+	checks := o.junitChecks()
+
+	// Group checks by suite, preserving the order in which suites were first seen
+	suiteIndex := map[string]int{}
+	for _, check := range checks {
+		idx, ok := suiteIndex[check.Suite]
+		if !ok {
+			idx = len(report.Suites)
+			suiteIndex[check.Suite] = idx
+			report.Suites = append(report.Suites, junitTestSuite{Name: check.Suite})
+		}
+		suite := &report.Suites[idx]
+
+		name := check.Name
+		if check.Source != "" {
+			name = fmt.Sprintf("%s: %s", check.Source, check.Name)
+		}
+		testCase := junitTestCase{
+			Name:      name,
+			Classname: check.Suite,
+			Time:      junitSeconds(check.Duration),
+		}
+		switch {
+		case !check.Passed && check.Optional:
+			// Skipped test cases don't fail CI builds, but are still highlighted by most CI systems
+			testCase.Skipped = &junitMessage{Message: "optional: " + check.Message}
+			suite.Skipped++
+		case !check.Passed:
+			testCase.Failure = &junitMessage{Message: check.Message, Type: check.Suite, Body: check.Message}
+			suite.Failures++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+		suite.Tests++
+	}
+
+	// Report exceptions and errors as errored test cases
+	verifierSuite := junitTestSuite{Name: junitVerifierSuite}
+	for i, item := range toErrorItems(o.exceptions) {
+		verifierSuite.TestCases = append(verifierSuite.TestCases, junitTestCase{
+			Name:      fmt.Sprintf("exception %d", i+1),
+			Classname: junitVerifierSuite,
+			Time:      junitSeconds(0),
+			Error:     &junitMessage{Message: item.Message, Type: "exception", Body: item.Message},
+		})
+	}
+	for i, item := range toErrorItems(o.errors) {
+		verifierSuite.TestCases = append(verifierSuite.TestCases, junitTestCase{
+			Name:      fmt.Sprintf("error %d", i+1),
+			Classname: junitVerifierSuite,
+			Time:      junitSeconds(0),
+			Error:     &junitMessage{Message: item.Message, Type: "error", Body: item.Message},
+		})
+	}
+	verifierSuite.Tests = len(verifierSuite.TestCases)
+	verifierSuite.Errors = len(verifierSuite.TestCases)
+	if verifierSuite.Tests > 0 {
+		report.Suites = append(report.Suites, verifierSuite)
+	}
+
+	// Attach run metadata to every suite and compute totals
+	properties := o.metadata.junitProperties()
+	for i := range report.Suites {
+		suite := &report.Suites[i]
+		suite.Properties = properties
+		var total time.Duration
+		for _, check := range checks {
+			if check.Suite == suite.Name {
+				total += check.Duration
+			}
+		}
+		suite.Time = junitSeconds(total)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Skipped += suite.Skipped
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// junitChecks converts each endpoint result into an "egress" Check and returns them followed by
+// all other recorded checks
+func (o *Output) junitChecks() []Check {
+	checks := make([]Check, 0, len(o.endpoints)+len(o.checks))
+	for _, result := range o.endpoints {
+		message := result.Message
+		if result.SatisfiedBy != "" {
+			// Failed members of passing anyOf groups don't fail the build
+			message = fmt.Sprintf("%s (any-of group '%s' satisfied by %s)", result.Message, result.AnyOf, result.SatisfiedBy)
+		}
+		checks = append(checks, Check{
+			Suite:    "egress",
+			Name:     result.HostPort(),
+			Passed:   result.Passed(),
+			Message:  message,
+			Duration: time.Duration(result.Timings.Total * float64(time.Second)),
+			Source:   result.Source,
+			Optional: result.Optional || result.SatisfiedBy != "",
+		})
+	}
+	return append(checks, o.checks...)
+}
+
+// junitProperties converts the non-empty metadata fields into JUnit suite properties
+func (md RunMetadata) junitProperties() []junitProperty {
+	var properties []junitProperty
+	for _, p := range []junitProperty{
+		{"platform", md.Platform},
+		{"region", md.Region},
+		{"subnetId", md.SubnetID},
+		{"vpcId", md.VpcID},
+		{"probe", md.Probe},
+		{"imageId", md.CloudImageID},
+		{"instanceType", md.InstanceType},
+		{"egressListSource", md.EgressListSource},
+		{"egressListSha", md.EgressListSHA},
+		{"egressListRef", md.EgressListRef},
+		{"egressListFallbackReason", md.EgressListFallbackReason},
+		{"source", md.Source},
+	} {
+		if p.Value != "" {
+			properties = append(properties, p)
+		}
+	}
+	if md.EgressListCached {
+		properties = append(properties, junitProperty{"egressListCached", "true"})
+	}
+	if md.EgressListUnverified {
+		properties = append(properties, junitProperty{"egressListUnverified", "true"})
+	}
+	return properties
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
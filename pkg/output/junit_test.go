@@ -0,0 +1,90 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOutput_WriteJUnit(t *testing.T) {
+	tests := []struct {
+		name          string
+		o             *Output
+		wantSuites    []string
+		wantTests     int
+		wantFailures  int
+		wantErrors    int
+		wantFailureOn string
+	}{
+		{
+			name:       "empty output",
+			o:          &Output{},
+			wantSuites: nil,
+		},
+		{
+			name: "egress checks",
+			o: &Output{
+				checks: []Check{
+					{Suite: "egress", Name: "quay.io:443", Passed: true, Duration: time.Second},
+					{Suite: "egress", Name: "example.com:443", Message: "Connection timed out"},
+				},
+			},
+			wantSuites:    []string{"egress"},
+			wantTests:     2,
+			wantFailures:  1,
+			wantFailureOn: "example.com:443",
+		},
+		{
+			name: "dns checks with exceptions and errors",
+			o: &Output{
+				checks: []Check{
+					{Suite: "dns", Name: "enableDnsSupport", Passed: true},
+					{Suite: "dns", Name: "enableDnsHostnames", Message: "must be true"},
+				},
+				exceptions: []error{errors.New("must be true")},
+				errors:     []error{errors.New("oops")},
+			},
+			wantSuites:    []string{"dns", junitVerifierSuite},
+			wantTests:     4,
+			wantFailures:  1,
+			wantErrors:    2,
+			wantFailureOn: "enableDnsHostnames",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.o.WriteJUnit(&buf); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got junitTestSuites
+			if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("failed to parse generated XML %s: %s", buf.String(), err)
+			}
+
+			if len(got.Suites) != len(tt.wantSuites) {
+				t.Fatalf("expected suites %v, got %+v", tt.wantSuites, got.Suites)
+			}
+			for i, suite := range got.Suites {
+				if suite.Name != tt.wantSuites[i] {
+					t.Errorf("expected suite %d to be %s, got %s", i, tt.wantSuites[i], suite.Name)
+				}
+			}
+			if got.Tests != tt.wantTests || got.Failures != tt.wantFailures || got.Errors != tt.wantErrors {
+				t.Errorf("expected tests=%d failures=%d errors=%d, got tests=%d failures=%d errors=%d",
+					tt.wantTests, tt.wantFailures, tt.wantErrors, got.Tests, got.Failures, got.Errors)
+			}
+			for _, suite := range got.Suites {
+				for _, tc := range suite.TestCases {
+					if tc.Failure != nil && tc.Name != tt.wantFailureOn {
+						t.Errorf("unexpected failure on test case %s", tc.Name)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+// metricsNamespace prefixes the name of every metric written by WriteMetrics
+const metricsNamespace = "osd_network_verifier"
+
+// MetricsContentType is the content type of the Prometheus text exposition format written by
+// WriteMetrics
+const MetricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// metricsBuckets are the upper bounds (in seconds) of the endpoint timing histograms' buckets. They
+// match the Prometheus client libraries' default buckets
+var metricsBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var metricLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+type metricLabel struct {
+	name, value string
+}
+
+type metricSample struct {
+	// suffix is appended to the family's name, e.g., "_bucket" for histograms
+	suffix string
+	labels []metricLabel
+	value  float64
+}
+
+type metricFamily struct {
+	name    string
+	help    string
+	kind    string
+	samples []metricSample
+}
+
+// metricHistogram accumulates observations into metricsBuckets
+type metricHistogram struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+func (h *metricHistogram) observe(v float64) {
+	if h.buckets == nil {
+		h.buckets = make([]uint64, len(metricsBuckets))
+	}
+	for i, upperBound := range metricsBuckets {
+		if v <= upperBound {
+			h.buckets[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+func (h *metricHistogram) samples(labels []metricLabel) []metricSample {
+	var samples []metricSample
+	for i, upperBound := range metricsBuckets {
+		samples = append(samples, metricSample{
+			suffix: "_bucket",
+			labels: withMetricLabels(labels, metricLabel{"le", formatMetricValue(upperBound)}),
+			value:  float64(h.buckets[i]),
+		})
+	}
+	return append(samples,
+		metricSample{suffix: "_bucket", labels: withMetricLabels(labels, metricLabel{"le", "+Inf"}), value: float64(h.count)},
+		metricSample{suffix: "_sum", labels: labels, value: h.sum},
+		metricSample{suffix: "_count", labels: labels, value: float64(h.count)},
+	)
+}
+
+// WriteMetrics writes the output to w in the Prometheus text exposition format, suitable for
+// node_exporter's textfile collector or for serving from a /metrics endpoint (see MetricsHandler).
+// Every metric is labelled with the run's platform, region and subnet. In merged outputs, the
+// subnet label of endpoint metrics and error counts holds the source of the run they came from
+func (o *Output) WriteMetrics(w io.Writer) error {
+	var b strings.Builder
+	for _, family := range o.snapshot().metricFamilies() {
+		if len(family.samples) == 0 {
+			continue
+		}
+		name := fmt.Sprintf("%s_%s", metricsNamespace, family.name)
+		fmt.Fprintf(&b, "# HELP %s %s\n", name, family.help)
+		fmt.Fprintf(&b, "# TYPE %s %s\n", name, family.kind)
+		for _, sample := range family.samples {
+			b.WriteString(name + sample.suffix)
+			if len(sample.labels) > 0 {
+				pairs := make([]string, 0, len(sample.labels))
+				for _, label := range sample.labels {
+					pairs = append(pairs, fmt.Sprintf(`%s="%s"`, label.name, metricLabelEscaper.Replace(label.value)))
+				}
+				b.WriteString("{" + strings.Join(pairs, ",") + "}")
+			}
+			b.WriteString(" " + formatMetricValue(sample.value) + "\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// MetricsHandler returns an http.Handler serving the output's metrics (see WriteMetrics)
+func (o *Output) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", MetricsContentType)
+		if err := o.WriteMetrics(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// metricFamilies builds every metric family describing the output. It must only be called on a
+// snapshot
+func (o *Output) metricFamilies() []metricFamily {
+	md := o.metadata
+	labelsFor := func(source string) []metricLabel {
+		subnet := md.SubnetID
+		if source != "" {
+			subnet = source
+		}
+		return []metricLabel{{"platform", md.Platform}, {"region", md.Region}, {"subnet", subnet}}
+	}
+
+	success := metricFamily{
+		name: "success",
+		help: "Whether the run found no failures, exceptions, or errors (1) or not (0).",
+		kind: "gauge",
+		samples: []metricSample{
+			{labels: labelsFor(""), value: boolMetricValue(o.IsSuccessful())},
+		},
+	}
+
+	runDuration := metricFamily{
+		name: "run_duration_seconds",
+		help: "Duration of the whole verifier run.",
+		kind: "gauge",
+	}
+	if o.timings.Run > 0 {
+		runDuration.samples = append(runDuration.samples, metricSample{labels: labelsFor(""), value: o.timings.Run.Seconds()})
+	}
+
+	instanceLaunch := metricFamily{
+		name: "instance_launch_duration_seconds",
+		help: "Time taken by the probe instance to reach the running state after it was requested.",
+		kind: "gauge",
+	}
+	if o.timings.InstanceLaunch > 0 {
+		instanceLaunch.samples = append(instanceLaunch.samples, metricSample{labels: labelsFor(""), value: o.timings.InstanceLaunch.Seconds()})
+	}
+
+	reachable := metricFamily{
+		name: "endpoint_reachable",
+		help: "Whether the probe reached the egress endpoint (1) or not (0).",
+		kind: "gauge",
+	}
+	// Results sharing a URL (e.g., an endpoint listed twice) would produce duplicate series, which
+	// Prometheus rejects, so they're reported once, as unreachable if any of them failed
+	reachableIndex := map[endpointKey]int{}
+	// Timing histograms are kept per subnet, in the order subnets were first seen
+	var subnets []string
+	connect := map[string]*metricHistogram{}
+	tlsHandshake := map[string]*metricHistogram{}
+	for _, result := range o.endpoints {
+		key := endpointKey{source: result.Source, url: result.URL}
+		if i, ok := reachableIndex[key]; ok {
+			reachable.samples[i].value = min(reachable.samples[i].value, boolMetricValue(result.Passed()))
+		} else {
+			reachableIndex[key] = len(reachable.samples)
+			reachable.samples = append(reachable.samples, metricSample{
+				labels: withMetricLabels(labelsFor(result.Source), metricLabel{"url", result.URL}),
+				value:  boolMetricValue(result.Passed()),
+			})
+		}
+
+		if _, ok := connect[result.Source]; !ok {
+			subnets = append(subnets, result.Source)
+			connect[result.Source] = &metricHistogram{}
+			tlsHandshake[result.Source] = &metricHistogram{}
+		}
+		// Curl reports zero for phases that never completed, which would skew the histograms
+		if result.Timings.Connect > 0 {
+			connect[result.Source].observe(result.Timings.Connect)
+		}
+		if result.Timings.AppConnect > 0 {
+			tlsHandshake[result.Source].observe(result.Timings.AppConnect - result.Timings.Connect)
+		}
+	}
+
+	connectDuration := metricFamily{
+		name: "endpoint_connect_duration_seconds",
+		help: "Time taken to establish a TCP connection to each egress endpoint.",
+		kind: "histogram",
+	}
+	tlsDuration := metricFamily{
+		name: "endpoint_tls_handshake_duration_seconds",
+		help: "Time taken to complete the TLS handshake with each egress endpoint, once connected.",
+		kind: "histogram",
+	}
+	for _, subnet := range subnets {
+		if connect[subnet].count > 0 {
+			connectDuration.samples = append(connectDuration.samples, connect[subnet].samples(labelsFor(subnet))...)
+		}
+		if tlsHandshake[subnet].count > 0 {
+			tlsDuration.samples = append(tlsDuration.samples, tlsHandshake[subnet].samples(labelsFor(subnet))...)
+		}
+	}
+
+	errorCounts := metricFamily{
+		name: "errors",
+		help: "Number of problems found by the run, by kind (failure, warning, exception, or error) and category. Only non-zero counts are reported.",
+		kind: "gauge",
+	}
+	for _, kind := range []struct {
+		name string
+		errs []error
+	}{
+		{"failure", o.allFailures()},
+		{"warning", o.allWarnings()},
+		{"exception", o.exceptions},
+		{"error", o.errors},
+	} {
+		type errorKey struct {
+			source   string
+			category handledErrors.Category
+		}
+		var keys []errorKey
+		counts := map[errorKey]int{}
+		for _, err := range kind.errs {
+			key := errorKey{category: metricErrorCategory(err)}
+			var se *sourcedError
+			if errors.As(err, &se) {
+				key.source = se.source
+			}
+			if _, ok := counts[key]; !ok {
+				keys = append(keys, key)
+			}
+			counts[key]++
+		}
+		for _, key := range keys {
+			errorCounts.samples = append(errorCounts.samples, metricSample{
+				labels: withMetricLabels(labelsFor(key.source), metricLabel{"kind", kind.name}, metricLabel{"category", string(key.category)}),
+				value:  float64(counts[key]),
+			})
+		}
+	}
+
+	return []metricFamily{success, runDuration, instanceLaunch, reachable, connectDuration, tlsDuration, errorCounts}
+}
+
+func metricErrorCategory(err error) handledErrors.Category {
+	var nve *handledErrors.GenericError
+	if errors.As(err, &nve) {
+		return nve.Category()
+	}
+	return handledErrors.NewGenericError(err).Category()
+}
+
+// withMetricLabels returns a copy of labels with extra appended, leaving labels unmodified
+func withMetricLabels(labels []metricLabel, extra ...metricLabel) []metricLabel {
+	return append(append([]metricLabel{}, labels...), extra...)
+}
+
+func boolMetricValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,126 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	nverr "github.com/openshift/osd-network-verifier/pkg/errors"
+)
+
+func TestOutput_WriteMetrics(t *testing.T) {
+	o := &Output{}
+	o.SetMetadata(RunMetadata{Platform: "aws-classic", Region: "us-east-1", SubnetID: "subnet-1"})
+	o.SetRunDuration(90 * time.Second)
+	o.SetInstanceLaunchDuration(15 * time.Second)
+	o.AddEndpointResult(EndpointResult{
+		URL:     "https://quay.io:443",
+		Status:  EndpointPassed,
+		Timings: EndpointTimings{Connect: 0.02, AppConnect: 0.07},
+	})
+	o.AddEndpointResult(EndpointResult{
+		URL:      "https://api.openshift.com:443",
+		Status:   EndpointFailed,
+		Category: FailureCategoryTimeout,
+	})
+	o.AddException(nverr.NewGenericError(errors.New("corrupt")).WithCode(nverr.CodeProbeCorrupt))
+
+	var b bytes.Buffer
+	if err := o.WriteMetrics(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := b.String()
+
+	labels := `platform="aws-classic",region="us-east-1",subnet="subnet-1"`
+	for _, want := range []string{
+		"# TYPE osd_network_verifier_success gauge\nosd_network_verifier_success{" + labels + "} 0\n",
+		"osd_network_verifier_run_duration_seconds{" + labels + "} 90\n",
+		"osd_network_verifier_instance_launch_duration_seconds{" + labels + "} 15\n",
+		`osd_network_verifier_endpoint_reachable{` + labels + `,url="https://quay.io:443"} 1` + "\n",
+		`osd_network_verifier_endpoint_reachable{` + labels + `,url="https://api.openshift.com:443"} 0` + "\n",
+		"# TYPE osd_network_verifier_endpoint_connect_duration_seconds histogram\n",
+		`osd_network_verifier_endpoint_connect_duration_seconds_bucket{` + labels + `,le="0.01"} 0` + "\n",
+		`osd_network_verifier_endpoint_connect_duration_seconds_bucket{` + labels + `,le="0.025"} 1` + "\n",
+		`osd_network_verifier_endpoint_connect_duration_seconds_bucket{` + labels + `,le="+Inf"} 1` + "\n",
+		"osd_network_verifier_endpoint_connect_duration_seconds_count{" + labels + "} 1\n",
+		`osd_network_verifier_endpoint_tls_handshake_duration_seconds_bucket{` + labels + `,le="0.05"} 1` + "\n",
+		`osd_network_verifier_errors{` + labels + `,kind="failure",category="egress"} 1` + "\n",
+		`osd_network_verifier_errors{` + labels + `,kind="exception",category="probe"} 1` + "\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected metrics to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `kind="error"`) {
+		t.Errorf("expected zero error counts to be omitted, got:\n%s", got)
+	}
+}
+
+func TestOutput_WriteMetrics_Merged(t *testing.T) {
+	run := &Output{}
+	run.SetMetadata(RunMetadata{Platform: "aws-classic", Region: "us-east-1", SubnetID: "subnet-2"})
+	run.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Status: EndpointFailed, Timings: EndpointTimings{Connect: 0.5}})
+
+	merged := &Output{}
+	merged.SetMetadata(RunMetadata{Platform: "aws-classic", Region: "us-east-1"})
+	merged.Merge(run)
+
+	var b bytes.Buffer
+	if err := merged.WriteMetrics(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := b.String()
+
+	labels := `platform="aws-classic",region="us-east-1",subnet="subnet-2"`
+	for _, want := range []string{
+		`osd_network_verifier_success{platform="aws-classic",region="us-east-1",subnet=""} 0` + "\n",
+		`osd_network_verifier_endpoint_reachable{` + labels + `,url="https://quay.io:443"} 0` + "\n",
+		"osd_network_verifier_endpoint_connect_duration_seconds_sum{" + labels + "} 0.5\n",
+		`osd_network_verifier_errors{` + labels + `,kind="failure",category="egress"} 1` + "\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected metrics to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "run_duration_seconds") || strings.Contains(got, "tls_handshake") {
+		t.Errorf("expected unmeasured metrics to be omitted, got:\n%s", got)
+	}
+}
+
+func TestOutput_WriteMetrics_DuplicateURL(t *testing.T) {
+	o := &Output{}
+	o.SetMetadata(RunMetadata{Platform: "aws-classic", Region: "us-east-1", SubnetID: "subnet-1"})
+	o.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Status: EndpointPassed})
+	o.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Status: EndpointFailed})
+
+	var b bytes.Buffer
+	if err := o.WriteMetrics(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Count(b.String(), "osd_network_verifier_endpoint_reachable{"); got != 1 {
+		t.Errorf("expected a single endpoint_reachable series, got %d:\n%s", got, b.String())
+	}
+	want := `osd_network_verifier_endpoint_reachable{platform="aws-classic",region="us-east-1",subnet="subnet-1",url="https://quay.io:443"} 0` + "\n"
+	if !strings.Contains(b.String(), want) {
+		t.Errorf("expected metrics to contain %q, got:\n%s", want, b.String())
+	}
+}
+
+func TestOutput_MetricsHandler(t *testing.T) {
+	o := &Output{}
+	o.SetMetadata(RunMetadata{Platform: "gcp-classic", Region: "us-east1", SubnetID: `sub"net`})
+
+	rec := httptest.NewRecorder()
+	o.MetricsHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != MetricsContentType {
+		t.Errorf("expected content type %q, got %q", MetricsContentType, ct)
+	}
+	want := `osd_network_verifier_success{platform="gcp-classic",region="us-east1",subnet="sub\"net"} 1` + "\n"
+	if !strings.Contains(rec.Body.String(), want) {
+		t.Errorf("expected body to contain %q, got:\n%s", want, rec.Body.String())
+	}
+}
@@ -3,6 +3,8 @@ package output
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
 )
@@ -10,7 +12,10 @@ import (
 const logFormat = " - %v\n"
 
 // Output can be used when showcasing validation results at the end of the execution.
+// It is safe for concurrent use; results from separate runs can be combined using Merge.
 type Output struct {
+	// mu guards all the fields below
+	mu sync.RWMutex
 	// debugLogs
 	debugLogs []string
 	// failures represents the failed validation tests
@@ -19,15 +24,149 @@ type Output struct {
 	exceptions []error
 	// errors is collection of unhandled errors
 	errors []error
+	// metadata describes the context in which the verifier was run
+	metadata RunMetadata
+	// checks records every individual verification test, including those that passed
+	checks []Check
+	// endpoints records the result of every egress endpoint tested by the probe
+	endpoints []EndpointResult
+	// timings records how long the run and its phases took
+	timings RunTimings
+}
+
+// RunTimings records how long a verifier run and its slowest phases took. Zero durations weren't
+// measured (e.g., no instance is launched by the dns command)
+type RunTimings struct {
+	// Run is the duration of the whole run
+	Run time.Duration
+	// InstanceLaunch is how long the probe instance took to reach the running state after it was
+	// requested
+	InstanceLaunch time.Duration
+}
+
+// Check records the outcome of a single verification test (e.g., reaching one egress endpoint or
+// validating one VPC attribute), regardless of whether it passed. Checks are used to build per-test
+// reports such as JUnit XML, which need to list successful tests as well as failed ones
+type Check struct {
+	// Suite groups related checks, e.g., "egress" or "dns"
+	Suite string
+	// Name identifies the check within its suite, e.g., "quay.io:443" or "enableDnsSupport"
+	Name string
+	// Passed is true if the check succeeded
+	Passed bool
+	// Message explains why the check failed (e.g., curl's error message). Empty for passed checks
+	Message string
+	// Duration is how long the check took to run, if known
+	Duration time.Duration
+	// Source labels the run the check came from (e.g., a subnet ID) in merged outputs
+	Source string
+	// Optional checks that fail are reported as warnings (e.g., skipped JUnit test cases) rather
+	// than failures
+	Optional bool
+}
+
+// RunMetadata describes the context in which a verifier run was performed. Fields are left empty
+// when they don't apply to the run (e.g., SubnetID for a DNS verification)
+type RunMetadata struct {
+	Platform         string `json:"platform,omitempty"`
+	Region           string `json:"region,omitempty"`
+	SubnetID         string `json:"subnetId,omitempty"`
+	VpcID            string `json:"vpcId,omitempty"`
+	Probe            string `json:"probe,omitempty"`
+	CloudImageID     string `json:"imageId,omitempty"`
+	InstanceType     string `json:"instanceType,omitempty"`
+	EgressListSource string `json:"egressListSource,omitempty"`
+	EgressListSHA    string `json:"egressListSha,omitempty"`
+	// EgressListRef is the git ref the egress list was fetched at. Empty for the default branch
+	EgressListRef string `json:"egressListRef,omitempty"`
+	// EgressListCached is true if the egress list was read from the on-disk cache
+	EgressListCached bool `json:"egressListCached,omitempty"`
+	// EgressListUnverified is true if the egress list was fetched without verifying its signature
+	EgressListUnverified bool `json:"egressListUnverified,omitempty"`
+	// EgressListFallbackReason explains why a cached or embedded egress list was used instead of
+	// the latest list from GitHub
+	EgressListFallbackReason string `json:"egressListFallbackReason,omitempty"`
+	// Source labels the run when its output is merged into another. Defaults to SubnetID, or
+	// VpcID if that's empty
+	Source string `json:"source,omitempty"`
+}
+
+// sourceLabel returns the label identifying the run's results in merged outputs
+func (md RunMetadata) sourceLabel() string {
+	switch {
+	case md.Source != "":
+		return md.Source
+	case md.SubnetID != "":
+		return md.SubnetID
+	default:
+		return md.VpcID
+	}
 }
 
 func (o *Output) AddDebugLogs(log string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	o.debugLogs = append(o.debugLogs, log)
 }
 
-// AddError adds error as generic to the list of errors
+// SetMetadata replaces the run metadata stored on the output
+func (o *Output) SetMetadata(metadata RunMetadata) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.metadata = metadata
+}
+
+// Metadata returns the run metadata stored on the output
+func (o *Output) Metadata() RunMetadata {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.metadata
+}
+
+// SetRunDuration records how long the whole run took
+func (o *Output) SetRunDuration(d time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.timings.Run = d
+}
+
+// SetInstanceLaunchDuration records how long the probe instance took to start
+func (o *Output) SetInstanceLaunchDuration(d time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.timings.InstanceLaunch = d
+}
+
+// Timings returns the durations recorded on the output
+func (o *Output) Timings() RunTimings {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.timings
+}
+
+// AddCheck records the outcome of a single verification test. Note that this doesn't affect
+// IsSuccessful(): failed checks must also be reported as failures or exceptions
+func (o *Output) AddCheck(check Check) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.checks = append(o.checks, check)
+}
+
+// Checks returns all recorded verification tests in the order they were added
+func (o *Output) Checks() []Check {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return append([]Check{}, o.checks...)
+}
+
+// AddError adds error as generic to the list of errors. Errors already built by pkg/errors are
+// added as-is so that their code is preserved
 func (o *Output) AddError(err error) *Output {
-	if err != nil {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if nve, ok := err.(*handledErrors.GenericError); ok {
+		o.errors = append(o.errors, nve)
+	} else if err != nil {
 		o.errors = append(o.errors, handledErrors.NewGenericError(err))
 	}
 
@@ -36,11 +175,15 @@ func (o *Output) AddError(err error) *Output {
 
 // AddException adds an exception to the list of exceptions
 func (o *Output) AddException(message error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	o.exceptions = append(o.exceptions, message)
 }
 
 // SetEgressFailures sets egress endpoint failures as a bulk update
 func (o *Output) SetEgressFailures(failures []string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
 	for _, f := range failures {
 		o.failures = append(o.failures, handledErrors.NewEgressURLError(f))
 	}
@@ -48,9 +191,16 @@ func (o *Output) SetEgressFailures(failures []string) {
 
 // IsSuccessful checks whether the output contains any item, returns false if there's any
 func (o *Output) IsSuccessful() bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
 	if len(o.errors) > 0 || len(o.exceptions) > 0 || len(o.failures) > 0 {
 		return false
 	}
+	for _, result := range o.endpoints {
+		if result.failing() {
+			return false
+		}
+	}
 
 	return true
 }
@@ -60,17 +210,27 @@ func (o *Output) Format(debug bool) string {
 	if o == nil {
 		return ""
 	}
+	o = o.snapshot()
 	output := ""
 	if debug {
 		output += "printing out debug logs from the execution:\n"
 		output += format(o.debugLogs)
 	}
+	warnings := o.allWarnings()
 	if o.IsSuccessful() {
 		output += "All tests passed!\n"
+		if len(warnings) > 0 {
+			output += "printing out warnings for optional endpoints:\n"
+			output += format(warnings)
+		}
 		return output
 	}
 	output += "printing out failures:\n"
-	output += format(o.failures)
+	output += format(o.allFailures())
+	if len(warnings) > 0 {
+		output += "printing out warnings for optional endpoints:\n"
+		output += format(warnings)
+	}
 	output += "printing out exceptions preventing the verifier from running the specific test:\n"
 	output += format(o.exceptions)
 	output += "printing out errors faced during the execution:\n"
@@ -100,7 +260,8 @@ func (o *Output) Summary(debug bool) {
 // - exceptions as []error
 // - errors as []error
 func (o *Output) Parse() ([]error, []error, []error) {
-	return o.failures, o.exceptions, o.errors
+	s := o.snapshot()
+	return s.allFailures(), s.exceptions, s.errors
 }
 
 // GetEgressURLFailures returns only errors related to network egress failures.
@@ -108,7 +269,7 @@ func (o *Output) Parse() ([]error, []error, []error) {
 func (o *Output) GetEgressURLFailures() []*handledErrors.GenericError {
 	egressErrs := []*handledErrors.GenericError{}
 
-	for _, err := range o.failures {
+	for _, err := range o.snapshot().allFailures() {
 		var nve *handledErrors.GenericError
 		if errors.As(err, &nve) {
 			if nve.EgressURL() != "" {
@@ -119,3 +280,89 @@ func (o *Output) GetEgressURLFailures() []*handledErrors.GenericError {
 
 	return egressErrs
 }
+
+// snapshot returns a copy of the output that can be read without holding o's lock. Methods that
+// read several fields use it to get a consistent view of the output
+func (o *Output) snapshot() *Output {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return &Output{
+		debugLogs:  append([]string{}, o.debugLogs...),
+		failures:   append([]error{}, o.failures...),
+		exceptions: append([]error{}, o.exceptions...),
+		errors:     append([]error{}, o.errors...),
+		metadata:   o.metadata,
+		checks:     append([]Check{}, o.checks...),
+		endpoints:  append([]EndpointResult{}, o.endpoints...),
+		timings:    o.timings,
+	}
+}
+
+// Merge appends all results of other to the output, e.g., to combine the outputs of verifier runs
+// performed concurrently against several subnets into a single report. Each merged endpoint
+// result, check, failure, exception, error, and debug log is labelled with the source of other
+// (see RunMetadata.Source). The output's own metadata and timings are left unchanged
+func (o *Output) Merge(other *Output) {
+	if other == nil {
+		return
+	}
+	s := other.snapshot()
+	source := s.metadata.sourceLabel()
+
+	for i := range s.endpoints {
+		if s.endpoints[i].Source == "" {
+			s.endpoints[i].Source = source
+		}
+	}
+	for i := range s.checks {
+		if s.checks[i].Source == "" {
+			s.checks[i].Source = source
+		}
+	}
+	if source != "" {
+		for i := range s.debugLogs {
+			s.debugLogs[i] = fmt.Sprintf("[%s] %s", source, s.debugLogs[i])
+		}
+		s.failures = withSource(source, s.failures)
+		s.exceptions = withSource(source, s.exceptions)
+		s.errors = withSource(source, s.errors)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.debugLogs = append(o.debugLogs, s.debugLogs...)
+	o.failures = append(o.failures, s.failures...)
+	o.exceptions = append(o.exceptions, s.exceptions...)
+	o.errors = append(o.errors, s.errors...)
+	o.checks = append(o.checks, s.checks...)
+	o.endpoints = append(o.endpoints, s.endpoints...)
+}
+
+// sourcedError labels an error with the source (e.g., subnet ID) of the run that produced it. It
+// unwraps to the original error, so errors.As still finds the underlying *GenericError
+type sourcedError struct {
+	source string
+	err    error
+}
+
+func (e *sourcedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.source, e.err)
+}
+
+func (e *sourcedError) Unwrap() error {
+	return e.err
+}
+
+// withSource labels each error with source, leaving errors that already have a source unchanged
+func withSource(source string, errs []error) []error {
+	labelled := make([]error, 0, len(errs))
+	for _, err := range errs {
+		var se *sourcedError
+		if errors.As(err, &se) {
+			labelled = append(labelled, err)
+			continue
+		}
+		labelled = append(labelled, &sourcedError{source: source, err: err})
+	}
+	return labelled
+}
@@ -2,6 +2,8 @@ package output
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	nverr "github.com/openshift/osd-network-verifier/pkg/errors"
@@ -28,6 +30,16 @@ func TestGetEgressURLFailures(t *testing.T) {
 			},
 			expected: 2,
 		},
+		{
+			name: "Failed endpoint results",
+			o: &Output{
+				endpoints: []EndpointResult{
+					{URL: "https://www.example.com:443", Host: "www.example.com", Port: 443, Status: EndpointFailed},
+					{URL: "https://www.example.org:443", Host: "www.example.org", Port: 443, Status: EndpointPassed},
+				},
+			},
+			expected: 1,
+		},
 		{
 			name: "Mixture of failures",
 			o: &Output{
@@ -50,3 +62,128 @@ func TestGetEgressURLFailures(t *testing.T) {
 		})
 	}
 }
+
+func TestAddError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantMessage string
+		wantCode    nverr.Code
+	}{
+		{
+			name:        "plain error",
+			err:         errors.New("oops"),
+			wantMessage: "network verifier error: oops",
+			wantCode:    nverr.CodeInternal,
+		},
+		{
+			name:        "coded error",
+			err:         nverr.NewGenericError(errors.New("bad flag")).WithCode(nverr.CodeInvalidConfiguration),
+			wantMessage: "network verifier error: bad flag",
+			wantCode:    nverr.CodeInvalidConfiguration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Output{}
+			o.AddError(tt.err)
+			_, _, errs := o.Parse()
+			var nve *nverr.GenericError
+			if len(errs) != 1 || !errors.As(errs[0], &nve) {
+				t.Fatalf("expected a single *GenericError, got %v", errs)
+			}
+			if nve.Error() != tt.wantMessage {
+				t.Errorf("expected message %q, got %q", tt.wantMessage, nve.Error())
+			}
+			if nve.Code() != tt.wantCode {
+				t.Errorf("expected code %v, got %v", tt.wantCode, nve.Code())
+			}
+		})
+	}
+}
+
+func TestOutput_Merge(t *testing.T) {
+	subnet1 := &Output{}
+	subnet1.SetMetadata(RunMetadata{Platform: "aws", SubnetID: "subnet-1"})
+	subnet1.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Host: "quay.io", Port: 443, Status: EndpointPassed})
+	subnet1.AddCheck(Check{Suite: "dns", Name: "enableDnsSupport", Passed: true})
+
+	subnet2 := &Output{}
+	subnet2.SetMetadata(RunMetadata{Platform: "aws", SubnetID: "subnet-2", Source: "private-a"})
+	subnet2.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Host: "quay.io", Port: 443, Status: EndpointFailed, Message: "timed out"})
+	subnet2.AddError(nverr.NewGenericError(errors.New("throttled")).WithCode(nverr.CodeCloudAPIThrottled))
+	subnet2.AddDebugLogs("launched instance")
+
+	merged := &Output{}
+	merged.SetMetadata(RunMetadata{Platform: "aws"})
+	merged.Merge(subnet1)
+	merged.Merge(subnet2)
+	merged.Merge(nil)
+
+	if merged.Metadata().SubnetID != "" {
+		t.Errorf("expected Merge to leave metadata unchanged, got %+v", merged.Metadata())
+	}
+	if merged.IsSuccessful() {
+		t.Errorf("expected merged output to be unsuccessful")
+	}
+
+	endpoints := merged.EndpointResults()
+	if len(endpoints) != 2 || endpoints[0].Source != "subnet-1" || endpoints[1].Source != "private-a" {
+		t.Fatalf("expected endpoints labelled with their source, got %+v", endpoints)
+	}
+	if checks := merged.Checks(); len(checks) != 1 || checks[0].Source != "subnet-1" {
+		t.Errorf("expected checks labelled with their source, got %+v", checks)
+	}
+
+	failures, _, errs := merged.Parse()
+	if len(failures) != 1 || failures[0].Error() != "private-a: egressURL error: https://quay.io:443 (timed out)" {
+		t.Errorf("expected a single labelled failure, got %v", failures)
+	}
+	var nve *nverr.GenericError
+	if len(errs) != 1 || !errors.As(errs[0], &nve) || nve.Code() != nverr.CodeCloudAPIThrottled {
+		t.Errorf("expected the merged error to keep its code, got %v", errs)
+	}
+
+	doc := merged.Document()
+	if len(doc.Errors) != 1 || doc.Errors[0].Source != "private-a" {
+		t.Errorf("expected the JSON error item to carry its source, got %+v", doc.Errors)
+	}
+	if len(doc.DebugLogs) != 1 || doc.DebugLogs[0] != "[private-a] launched instance" {
+		t.Errorf("expected labelled debug logs, got %v", doc.DebugLogs)
+	}
+
+	// Merging an already-merged output must not relabel its results
+	outer := &Output{}
+	outer.Merge(merged)
+	if failures, _, _ := outer.Parse(); len(failures) != 1 || failures[0].Error() != "private-a: egressURL error: https://quay.io:443 (timed out)" {
+		t.Errorf("expected labels to be preserved, got %v", failures)
+	}
+}
+
+func TestOutput_Concurrent(t *testing.T) {
+	o := &Output{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			run := &Output{}
+			run.SetMetadata(RunMetadata{SubnetID: fmt.Sprintf("subnet-%d", i)})
+			run.AddEndpointResult(EndpointResult{URL: "https://quay.io:443", Status: EndpointPassed})
+			run.AddException(errors.New("oops"))
+			o.Merge(run)
+			o.AddDebugLogs("done")
+			_ = o.Format(false)
+			_ = o.IsSuccessful()
+		}(i)
+	}
+	wg.Wait()
+
+	if n := len(o.EndpointResults()); n != 10 {
+		t.Errorf("expected 10 endpoint results, got %d", n)
+	}
+	if _, exceptions, _ := o.Parse(); len(exceptions) != 10 {
+		t.Errorf("expected 10 exceptions, got %d", len(exceptions))
+	}
+}
@@ -0,0 +1,322 @@
+package output
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/openshift/osd-network-verifier/version"
+)
+
+// report is the view model shared by the HTML and Markdown report templates
+type report struct {
+	Version     string
+	GeneratedAt string
+	Successful  bool
+	Metadata    []junitProperty
+	Failed      []reportEndpoint
+	Passed      []reportEndpoint
+	// Warnings holds failed optional endpoints
+	Warnings []reportEndpoint
+	// Wildcards groups the results of the sample hosts probed for wildcard hosts
+	Wildcards []WildcardResult
+	// Groups summarizes anyOf groups. Failed members of passing groups are only listed there
+	Groups []GroupResult
+	// Checks holds the non-egress checks (e.g., DNS attributes), which have no endpoint result
+	Checks     []Check
+	Failures   []ErrorItem
+	Exceptions []ErrorItem
+	Errors     []ErrorItem
+}
+
+type reportEndpoint struct {
+	EndpointResult
+	Remediation string
+	Seconds     string
+}
+
+// newReport builds the report view model. Failures that aren't backed by an endpoint result (e.g.,
+// those reported by the legacy probe) are listed separately so that nothing is left out
+func (o *Output) newReport() report {
+	o = o.snapshot()
+	r := report{
+		Version:     version.Version,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Successful:  o.IsSuccessful(),
+		Metadata:    o.metadata.junitProperties(),
+		Failures:    toErrorItems(o.failures),
+		Exceptions:  toErrorItems(o.exceptions),
+		Errors:      toErrorItems(o.errors),
+		Wildcards:   wildcardResults(o.endpoints),
+		Groups:      groupResults(o.endpoints),
+	}
+	for _, result := range o.endpoints {
+		e := reportEndpoint{
+			EndpointResult: result,
+			Remediation:    result.Category.Remediation(),
+			Seconds:        junitSeconds(time.Duration(result.Timings.Total * float64(time.Second))),
+		}
+		switch {
+		case result.Passed():
+			r.Passed = append(r.Passed, e)
+		case result.SatisfiedBy != "":
+			continue
+		case result.Optional:
+			r.Warnings = append(r.Warnings, e)
+		default:
+			r.Failed = append(r.Failed, e)
+		}
+	}
+	for _, check := range o.checks {
+		// The legacy probe records egress checks alongside its failures; those are already listed
+		if check.Suite != "egress" {
+			r.Checks = append(r.Checks, check)
+		}
+	}
+	return r
+}
+
+// WriteHTML writes the output to w as a self-contained HTML report (no external stylesheets or
+// scripts), suitable for attaching to support cases. Endpoints are grouped by pass/fail, and each
+// failed endpoint is shown alongside its failure category and suggested remediation
+func (o *Output) WriteHTML(w io.Writer) error {
+	return htmlReportTemplate.Execute(w, o.newReport())
+}
+
+// WriteMarkdown writes the output to w as a Markdown report with the same content as WriteHTML
+func (o *Output) WriteMarkdown(w io.Writer) error {
+	return markdownReportTemplate.Execute(w, o.newReport())
+}
+
+// markdownCell escapes s so that it can be safely placed in a Markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r", "")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+var htmlReportTemplate = htmltemplate.Must(htmltemplate.New("report.html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>OSD Network Verifier Report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #151515; }
+h1 { font-size: 1.6em; }
+h2 { font-size: 1.25em; margin-top: 1.5em; border-bottom: 1px solid #d2d2d2; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #d2d2d2; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+code { font-family: "Red Hat Mono", Menlo, Consolas, monospace; }
+.result { padding: 0.5em 1em; font-weight: bold; display: inline-block; }
+.pass { background: #f3faf2; color: #1e4f18; }
+.fail { background: #faeae8; color: #7d1007; }
+</style>
+</head>
+<body>
+<h1>OSD Network Verifier Report</h1>
+{{if .Successful}}<p class="result pass">All tests passed!</p>{{else}}<p class="result fail">Verification failed</p>{{end}}
+<p>Generated at {{.GeneratedAt}}{{with .Version}} by osd-network-verifier {{.}}{{end}}</p>
+{{- with .Metadata}}
+<h2>Run Details</h2>
+<table>
+{{- range .}}
+<tr><th>{{.Name}}</th><td><code>{{.Value}}</code></td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Failed}}
+<h2>Failed Endpoints ({{len .}})</h2>
+<table>
+<tr><th>Endpoint</th><th>Category</th><th>Details</th><th>Remediation</th></tr>
+{{- range .}}
+<tr><td>{{with .Source}}{{.}}: {{end}}<code>{{.URL}}</code>{{with .Wildcard}}<br>sample of <code>{{.}}</code>{{end}}{{with .Info}}{{with .Description}}<br>{{.}}{{end}}{{end}}</td><td>{{.Category}}</td><td>{{.Message}}</td><td>{{.Remediation}}{{with .Info}}{{with .DocsURL}} <a href="{{.}}">Documentation</a>{{end}}{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Warnings}}
+<h2>Warnings ({{len .}})</h2>
+<p>These optional endpoints couldn't be reached. They don't affect the result, but some features may not work.</p>
+<table>
+<tr><th>Endpoint</th><th>Category</th><th>Details</th><th>Remediation</th></tr>
+{{- range .}}
+<tr><td>{{with .Source}}{{.}}: {{end}}<code>{{.URL}}</code>{{with .Wildcard}}<br>sample of <code>{{.}}</code>{{end}}{{with .Info}}{{with .Description}}<br>{{.}}{{end}}{{end}}</td><td>{{.Category}}</td><td>{{.Message}}</td><td>{{.Remediation}}{{with .Info}}{{with .DocsURL}} <a href="{{.}}">Documentation</a>{{end}}{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Wildcards}}
+<h2>Wildcard Hosts ({{len .}})</h2>
+<p>Wildcard hosts can't be probed directly, so sample subdomains were probed instead. A wildcard fails if any of its samples failed.</p>
+<table>
+<tr><th>Wildcard</th><th>Port</th><th>Result</th><th>Failed Samples</th></tr>
+{{- range .}}
+<tr><td>{{with .Source}}{{.}}: {{end}}<code>{{.Wildcard}}</code></td><td>{{.Port}}</td>{{if .Passed}}<td class="pass">pass</td>{{else}}<td class="fail">fail{{if .Optional}} (optional){{end}}</td>{{end}}<td>{{len .FailedURLs}} of {{len .SampleURLs}}{{range .FailedURLs}}<br><code>{{.}}</code>{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Groups}}
+<h2>Any-of Groups ({{len .}})</h2>
+<p>Each group lists alternative endpoints, e.g., mirrors or CDNs. A group passes if any of its members passed.</p>
+<table>
+<tr><th>Group</th><th>Port</th><th>Result</th><th>Satisfied By</th><th>Members</th></tr>
+{{- range .}}
+<tr><td>{{with .Source}}{{.}}: {{end}}<code>{{.AnyOf}}</code></td><td>{{.Port}}</td>{{if .Passed}}<td class="pass">pass</td>{{else}}<td class="fail">fail{{if .Optional}} (optional){{end}}</td>{{end}}<td>{{range $i, $url := .PassedURLs}}{{if $i}}<br>{{end}}<code>{{$url}}</code>{{end}}</td><td>{{range $i, $url := .MemberURLs}}{{if $i}}<br>{{end}}<code>{{$url}}</code>{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Failures}}
+<h2>Other Failures ({{len .}})</h2>
+<ul>
+{{- range .}}
+<li><code>{{.Code}}</code> {{.Message}}{{with .Remediation}}<br><em>{{.}}</em>{{end}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .Exceptions}}
+<h2>Exceptions ({{len .}})</h2>
+<p>These prevented a verification test from running as expected.</p>
+<ul>
+{{- range .}}
+<li><code>{{.Code}}</code> {{.Message}}{{with .Remediation}}<br><em>{{.}}</em>{{end}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .Errors}}
+<h2>Errors ({{len .}})</h2>
+<ul>
+{{- range .}}
+<li><code>{{.Code}}</code> {{.Message}}{{with .Remediation}}<br><em>{{.}}</em>{{end}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .Checks}}
+<h2>Checks</h2>
+<table>
+<tr><th>Suite</th><th>Check</th><th>Result</th><th>Details</th></tr>
+{{- range .}}
+<tr><td>{{.Suite}}</td><td>{{with .Source}}{{.}}: {{end}}<code>{{.Name}}</code></td>{{if .Passed}}<td class="pass">pass</td>{{else}}<td class="fail">fail</td>{{end}}<td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Passed}}
+<h2>Passed Endpoints ({{len .}})</h2>
+<table>
+<tr><th>Endpoint</th><th>Remote IP</th><th>Time (s)</th></tr>
+{{- range .}}
+<tr><td>{{with .Source}}{{.}}: {{end}}<code>{{.URL}}</code></td><td>{{.RemoteIP}}</td><td>{{.Seconds}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+var markdownReportTemplate = texttemplate.Must(texttemplate.New("report.md").Funcs(texttemplate.FuncMap{
+	"cell": markdownCell,
+}).Parse(`# OSD Network Verifier Report
+
+{{if .Successful}}**Result: All tests passed!**{{else}}**Result: Verification failed**{{end}}
+
+Generated at {{.GeneratedAt}}{{with .Version}} by osd-network-verifier {{.}}{{end}}
+{{- with .Metadata}}
+
+## Run Details
+
+| Property | Value |
+|----------|-------|
+{{- range .}}
+| {{cell .Name}} | ` + "`{{cell .Value}}`" + ` |
+{{- end}}
+{{- end}}
+{{- with .Failed}}
+
+## Failed Endpoints ({{len .}})
+
+| Endpoint | Category | Details | Remediation |
+|----------|----------|---------|-------------|
+{{- range .}}
+| {{with .Source}}{{cell .}}: {{end}}` + "`{{cell .URL}}`" + `{{with .Wildcard}}<br>sample of ` + "`{{cell .}}`" + `{{end}}{{with .Info}}{{with .Description}}<br>{{cell .}}{{end}}{{end}} | {{cell (print .Category)}} | {{cell .Message}} | {{cell .Remediation}}{{with .Info}}{{with .DocsURL}} [Documentation]({{.}}){{end}}{{end}} |
+{{- end}}
+{{- end}}
+{{- with .Warnings}}
+
+## Warnings ({{len .}})
+
+These optional endpoints couldn't be reached. They don't affect the result, but some features may not work.
+
+| Endpoint | Category | Details | Remediation |
+|----------|----------|---------|-------------|
+{{- range .}}
+| {{with .Source}}{{cell .}}: {{end}}` + "`{{cell .URL}}`" + `{{with .Wildcard}}<br>sample of ` + "`{{cell .}}`" + `{{end}}{{with .Info}}{{with .Description}}<br>{{cell .}}{{end}}{{end}} | {{cell (print .Category)}} | {{cell .Message}} | {{cell .Remediation}}{{with .Info}}{{with .DocsURL}} [Documentation]({{.}}){{end}}{{end}} |
+{{- end}}
+{{- end}}
+{{- with .Wildcards}}
+
+## Wildcard Hosts ({{len .}})
+
+Wildcard hosts can't be probed directly, so sample subdomains were probed instead. A wildcard fails if any of its samples failed.
+
+| Wildcard | Port | Result | Failed Samples |
+|----------|------|--------|----------------|
+{{- range .}}
+| {{with .Source}}{{cell .}}: {{end}}` + "`{{cell .Wildcard}}`" + ` | {{.Port}} | {{if .Passed}}pass{{else}}fail{{if .Optional}} (optional){{end}}{{end}} | {{len .FailedURLs}} of {{len .SampleURLs}}{{range .FailedURLs}}<br>` + "`{{cell .}}`" + `{{end}} |
+{{- end}}
+{{- end}}
+{{- with .Groups}}
+
+## Any-of Groups ({{len .}})
+
+Each group lists alternative endpoints, e.g., mirrors or CDNs. A group passes if any of its members passed.
+
+| Group | Port | Result | Satisfied By | Members |
+|-------|------|--------|--------------|---------|
+{{- range .}}
+| {{with .Source}}{{cell .}}: {{end}}` + "`{{cell .AnyOf}}`" + ` | {{.Port}} | {{if .Passed}}pass{{else}}fail{{if .Optional}} (optional){{end}}{{end}} | {{range $i, $url := .PassedURLs}}{{if $i}}<br>{{end}}` + "`{{cell $url}}`" + `{{end}} | {{range $i, $url := .MemberURLs}}{{if $i}}<br>{{end}}` + "`{{cell $url}}`" + `{{end}} |
+{{- end}}
+{{- end}}
+{{- with .Failures}}
+
+## Other Failures ({{len .}})
+{{range .}}
+- ` + "`{{.Code}}`" + ` {{.Message}}{{with .Remediation}}<br>_{{.}}_{{end}}
+{{- end}}
+{{- end}}
+{{- with .Exceptions}}
+
+## Exceptions ({{len .}})
+
+These prevented a verification test from running as expected.
+{{range .}}
+- ` + "`{{.Code}}`" + ` {{.Message}}{{with .Remediation}}<br>_{{.}}_{{end}}
+{{- end}}
+{{- end}}
+{{- with .Errors}}
+
+## Errors ({{len .}})
+{{range .}}
+- ` + "`{{.Code}}`" + ` {{.Message}}{{with .Remediation}}<br>_{{.}}_{{end}}
+{{- end}}
+{{- end}}
+{{- with .Checks}}
+
+## Checks
+
+| Suite | Check | Result | Details |
+|-------|-------|--------|---------|
+{{- range .}}
+| {{cell .Suite}} | {{with .Source}}{{cell .}}: {{end}}` + "`{{cell .Name}}`" + ` | {{if .Passed}}pass{{else}}fail{{end}} | {{cell .Message}} |
+{{- end}}
+{{- end}}
+{{- with .Passed}}
+
+## Passed Endpoints ({{len .}})
+
+| Endpoint | Remote IP | Time (s) |
+|----------|-----------|----------|
+{{- range .}}
+| {{with .Source}}{{cell .}}: {{end}}` + "`{{cell .URL}}`" + ` | {{cell .RemoteIP}} | {{.Seconds}} |
+{{- end}}
+{{- end}}
+`))
@@ -0,0 +1,97 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestOutput_WriteReport(t *testing.T) {
+	o := &Output{
+		metadata: RunMetadata{Platform: "aws-classic", Region: "us-east-1"},
+		endpoints: []EndpointResult{
+			{URL: "https://quay.io:443", Host: "quay.io", Port: 443, Status: EndpointPassed},
+			{
+				URL:      "https://www.example.com:443",
+				Host:     "www.example.com",
+				Port:     443,
+				Status:   EndpointFailed,
+				Category: FailureCategoryUnreachable,
+				Message:  "Connection timed out <after 5000 ms> | retrying",
+			},
+			{URL: "https://a.apps.example.com:443", Host: "a.apps.example.com", Port: 443, Status: EndpointPassed, Wildcard: "*.apps.example.com"},
+			{URL: "https://b.apps.example.com:443", Host: "b.apps.example.com", Port: 443, Status: EndpointFailed, Category: FailureCategoryTimeout, Wildcard: "*.apps.example.com"},
+			{URL: "https://mirror-a.example.com:443", Host: "mirror-a.example.com", Port: 443, Status: EndpointFailed, Category: FailureCategoryTimeout, AnyOf: "mirrors", SatisfiedBy: "https://mirror-b.example.com:443"},
+			{URL: "https://mirror-b.example.com:443", Host: "mirror-b.example.com", Port: 443, Status: EndpointPassed, AnyOf: "mirrors"},
+		},
+		checks:     []Check{{Suite: "dns", Name: "enableDnsSupport", Passed: true}},
+		exceptions: []error{errors.New("oops")},
+	}
+
+	tests := []struct {
+		name     string
+		write    func(*Output, *bytes.Buffer) error
+		want     []string
+		dontWant []string
+	}{
+		{
+			name:  "html",
+			write: func(o *Output, b *bytes.Buffer) error { return o.WriteHTML(b) },
+			want: []string{
+				"Verification failed",
+				"Failed Endpoints (2)",
+				"Passed Endpoints (3)",
+				"Wildcard Hosts (1)",
+				"Any-of Groups (1)",
+				`<td><code>mirrors</code></td><td>443</td><td class="pass">pass</td><td><code>https://mirror-b.example.com:443</code></td><td><code>https://mirror-a.example.com:443</code><br><code>https://mirror-b.example.com:443</code></td>`,
+				"<code>https://b.apps.example.com:443</code><br>sample of <code>*.apps.example.com</code>",
+				`<td><code>*.apps.example.com</code></td><td>443</td><td class="fail">fail</td><td>1 of 2<br><code>https://b.apps.example.com:443</code></td>`,
+				"https://www.example.com:443",
+				"Connection timed out &lt;after 5000 ms&gt; | retrying",
+				"allow outbound traffic to this endpoint",
+				"<th>region</th><td><code>us-east-1</code></td>",
+				"enableDnsSupport",
+				"Exceptions (1)",
+			},
+			dontWant: []string{"<after 5000 ms>", "<script", "<link"},
+		},
+		{
+			name:  "markdown",
+			write: func(o *Output, b *bytes.Buffer) error { return o.WriteMarkdown(b) },
+			want: []string{
+				"**Result: Verification failed**",
+				"## Failed Endpoints (2)",
+				"## Passed Endpoints (3)",
+				"## Wildcard Hosts (1)",
+				"## Any-of Groups (1)",
+				"| `mirrors` | 443 | pass | `https://mirror-b.example.com:443` | `https://mirror-a.example.com:443`<br>`https://mirror-b.example.com:443` |",
+				"| `*.apps.example.com` | 443 | fail | 1 of 2<br>`https://b.apps.example.com:443` |",
+				"| `https://www.example.com:443` | unreachable | Connection timed out <after 5000 ms> \\| retrying |",
+				"| region | `us-east-1` |",
+				"| dns | `enableDnsSupport` | pass |  |",
+				"- `ONV-INTERNAL` oops",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.write(o, &buf); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected report to contain %q, got:\n%s", want, got)
+				}
+			}
+			for _, dontWant := range tt.dontWant {
+				if strings.Contains(got, dontWant) {
+					t.Errorf("expected report not to contain %q, got:\n%s", dontWant, got)
+				}
+			}
+		})
+	}
+}
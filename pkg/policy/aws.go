@@ -0,0 +1,99 @@
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+)
+
+// AWS Network Firewall domain list target types and generated rule types
+const (
+	awsTargetTLSSNI   = "TLS_SNI"
+	awsTargetHTTPHost = "HTTP_HOST"
+	awsAllowlist      = "ALLOWLIST"
+	awsDenylist       = "DENYLIST"
+)
+
+// awsRulesSourceList is the domain list of an AWS Network Firewall stateful rule group
+type awsRulesSourceList struct {
+	Targets            []string `json:"Targets"`
+	TargetTypes        []string `json:"TargetTypes"`
+	GeneratedRulesType string   `json:"GeneratedRulesType"`
+}
+
+type awsRuleGroupDocument struct {
+	RulesSource *struct {
+		RulesSourceList *awsRulesSourceList `json:"RulesSourceList"`
+	} `json:"RulesSource"`
+	// RuleGroup wraps the rule group in the output of "aws network-firewall describe-rule-group"
+	RuleGroup *awsRuleGroupDocument `json:"RuleGroup"`
+}
+
+// awsNetworkFirewallPolicy evaluates requests against the rules AWS Network Firewall generates for a
+// domain list: allowlists pass matching TLS SNIs and HTTP Host headers and drop all other TLS and
+// HTTP traffic, while denylists drop matching traffic
+type awsNetworkFirewallPolicy struct {
+	list awsRulesSourceList
+}
+
+// parseAWSNetworkFirewall parses a stateful rule group holding a domain list, either on its own or
+// wrapped as in the output of "aws network-firewall describe-rule-group"
+func parseAWSNetworkFirewall(data []byte) (*awsNetworkFirewallPolicy, error) {
+	var doc awsRuleGroupDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid AWS Network Firewall rule group: %w", err)
+	}
+	if doc.RuleGroup != nil {
+		doc = *doc.RuleGroup
+	}
+	if doc.RulesSource == nil || doc.RulesSource.RulesSourceList == nil {
+		return nil, errors.New("unsupported AWS Network Firewall rule group: only domain lists (RulesSource.RulesSourceList) are supported")
+	}
+
+	list := *doc.RulesSource.RulesSourceList
+	if list.GeneratedRulesType != awsAllowlist && list.GeneratedRulesType != awsDenylist {
+		return nil, fmt.Errorf("unknown GeneratedRulesType '%s', must be %s or %s", list.GeneratedRulesType, awsAllowlist, awsDenylist)
+	}
+	for _, targetType := range list.TargetTypes {
+		if targetType != awsTargetTLSSNI && targetType != awsTargetHTTPHost {
+			return nil, fmt.Errorf("unknown TargetType '%s', must be %s or %s", targetType, awsTargetTLSSNI, awsTargetHTTPHost)
+		}
+	}
+	return &awsNetworkFirewallPolicy{list: list}, nil
+}
+
+func (p *awsNetworkFirewallPolicy) Evaluate(req Request) Verdict {
+	var targetType string
+	switch req.Protocol {
+	case egress_lists.ProtocolHTTP:
+		targetType = awsTargetHTTPHost
+	case egress_lists.ProtocolHTTPS, egress_lists.ProtocolTLS:
+		targetType = awsTargetTLSSNI
+	default:
+		return Verdict{Decision: DecisionUnknown, Reason: "domain lists only inspect TLS and HTTP traffic, so the firewall policy's default actions apply"}
+	}
+	if !slices.Contains(p.list.TargetTypes, targetType) {
+		return Verdict{Decision: DecisionUnknown, Reason: fmt.Sprintf("rule group doesn't inspect %s, so the firewall policy's default actions apply", targetType)}
+	}
+	if net.ParseIP(req.Host) != nil {
+		return Verdict{Decision: DecisionUnknown, Reason: "requests to IP addresses have no domain to match, so the firewall policy's default actions apply"}
+	}
+
+	for _, target := range p.list.Targets {
+		if !matchDomain(target, req.Host) {
+			continue
+		}
+		if p.list.GeneratedRulesType == awsDenylist {
+			return Verdict{Decision: DecisionBlock, Reason: fmt.Sprintf("denied by %s target '%s'", targetType, target)}
+		}
+		return Verdict{Decision: DecisionAllow, Reason: fmt.Sprintf("allowed by %s target '%s'", targetType, target)}
+	}
+	if p.list.GeneratedRulesType == awsDenylist {
+		return Verdict{Decision: DecisionAllow, Reason: "not in the deny list"}
+	}
+	return Verdict{Decision: DecisionBlock, Reason: fmt.Sprintf("%s doesn't match any %s target", req.Host, targetType)}
+}
@@ -0,0 +1,89 @@
+package policy
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// domainEntry is a single line of a domain allowlist
+type domainEntry struct {
+	// pattern is a domain pattern (see matchDomain), or empty for network entries
+	pattern string
+	network *net.IPNet
+	// port is the only port allowed, or 0 for any port
+	port int
+	line int
+	text string
+}
+
+// domainsPolicy allows requests to hosts matching one of its entries, and blocks all others
+type domainsPolicy struct {
+	entries []domainEntry
+}
+
+// parseDomains parses a domain allowlist: one domain (optionally starting with "." or "*." to allow
+// its subdomains), IP address or CIDR per line, each optionally followed by ":port" to only allow
+// that port. Blank lines and "#" comments are ignored
+func parseDomains(data []byte) (*domainsPolicy, error) {
+	p := &domainsPolicy{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		entry := domainEntry{line: line, text: text}
+		value := text
+		if host, port, err := net.SplitHostPort(text); err == nil {
+			value = host
+			entry.port, err = strconv.Atoi(port)
+			if err != nil || entry.port < 1 || entry.port > 65535 {
+				return nil, fmt.Errorf("line %d: invalid port in '%s'", line, text)
+			}
+		}
+		if ip := net.ParseIP(value); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			entry.network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		} else if _, network, err := net.ParseCIDR(value); err == nil {
+			entry.network = network
+		} else if strings.ContainsAny(value, " \t/") {
+			return nil, fmt.Errorf("line %d: invalid entry '%s'", line, text)
+		} else {
+			entry.pattern = value
+		}
+		p.entries = append(p.entries, entry)
+	}
+	return p, scanner.Err()
+}
+
+// Evaluate allows requests matching an entry. Requests to hostnames that no domain pattern matches
+// are only blocked if the allowlist has no network entries for their port, as those may cover the
+// addresses the hostname resolves to
+func (p *domainsPolicy) Evaluate(req Request) Verdict {
+	ip := net.ParseIP(req.Host)
+	var networkEntry *domainEntry
+	for i, entry := range p.entries {
+		if entry.port != 0 && entry.port != req.Port {
+			continue
+		}
+		if (entry.network != nil && ip != nil && entry.network.Contains(ip)) || (entry.pattern != "" && matchDomain(entry.pattern, req.Host)) {
+			return Verdict{Decision: DecisionAllow, Reason: fmt.Sprintf("allowed by '%s' (line %d)", entry.text, entry.line)}
+		}
+		if entry.network != nil && ip == nil && networkEntry == nil {
+			networkEntry = &p.entries[i]
+		}
+	}
+	if networkEntry != nil {
+		return Verdict{Decision: DecisionUnknown, Reason: fmt.Sprintf("not allowed by any domain, but '%s' (line %d) may allow the addresses it resolves to, which can't be evaluated statically", networkEntry.text, networkEntry.line)}
+	}
+	return Verdict{Decision: DecisionBlock, Reason: "not in the allowlist"}
+}
@@ -0,0 +1,196 @@
+// Package policy statically evaluates customer firewall and proxy allowlists against egress lists,
+// predicting which endpoints they would block without launching anything
+package policy
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+)
+
+// Formats supported by Parse
+const (
+	// FormatSquid is a squid.conf file, evaluated through its "acl" and "http_access" directives
+	FormatSquid = "squid"
+	// FormatDomains is a plain allowlist holding one domain, IP address or CIDR per line
+	FormatDomains = "domains"
+	// FormatAWSNetworkFirewall is an AWS Network Firewall stateful domain list rule group, e.g., as
+	// produced by "egress-list export --format aws-network-firewall"
+	FormatAWSNetworkFirewall = "aws-network-firewall"
+)
+
+// Formats lists every format supported by Parse
+var Formats = []string{FormatSquid, FormatDomains, FormatAWSNetworkFirewall}
+
+// Decision is a policy's verdict on a request
+type Decision string
+
+const (
+	// DecisionAllow means the policy would allow the request
+	DecisionAllow Decision = "allow"
+	// DecisionBlock means the policy would block the request
+	DecisionBlock Decision = "block"
+	// DecisionUnknown means the policy's verdict can't be determined statically, e.g., because it
+	// depends on the addresses a hostname resolves to
+	DecisionUnknown Decision = "unknown"
+)
+
+// Request is a connection to an egress endpoint, as evaluated by a Policy
+type Request struct {
+	Host     string
+	Port     int
+	Protocol string
+	// Method is the method the proxy receives: CONNECT for all but plain http requests
+	Method string
+	// Path is the URL path of plain http requests, e.g., "/healthz"
+	Path string
+}
+
+// url returns the URL of the request as a proxy sees it: "host:port" for CONNECT requests, and an
+// absolute http URL otherwise
+func (req Request) url() string {
+	hostPort := net.JoinHostPort(req.Host, strconv.Itoa(req.Port))
+	if req.Method == "CONNECT" {
+		return hostPort
+	}
+	if req.Port == 80 {
+		hostPort = strings.TrimSuffix(hostPort, ":80")
+	}
+	path := req.Path
+	if path == "" {
+		path = "/"
+	}
+	return "http://" + hostPort + path
+}
+
+// Verdict is a policy's decision on a request, and why it was made
+type Verdict struct {
+	Decision Decision
+	// Reason explains the decision, e.g., by quoting the rule that matched
+	Reason string
+}
+
+// Policy is a parsed firewall or proxy allowlist
+type Policy interface {
+	// Evaluate returns the policy's verdict on req
+	Evaluate(req Request) Verdict
+}
+
+// Result is the verdict of a policy on a single host and port of an egress list
+type Result struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Protocol string   `json:"protocol"`
+	Required bool     `json:"required"`
+	Decision Decision `json:"decision"`
+	Reason   string   `json:"reason,omitempty"`
+	// AnyOf names the group of alternative endpoints the endpoint belongs to, if any
+	AnyOf string `json:"anyOf,omitempty"`
+	// SatisfiedBy is the host:port of a member of the endpoint's AnyOf group the policy allows, if
+	// the endpoint itself isn't allowed
+	SatisfiedBy string `json:"satisfiedBy,omitempty"`
+}
+
+// Blocked returns true if the policy would block a required endpoint, and no alternative in its
+// anyOf group
+func (r Result) Blocked() bool {
+	return r.Decision == DecisionBlock && r.Required && r.SatisfiedBy == ""
+}
+
+// Parse parses data, a policy in format (one of Formats). readFile reads the files a policy may
+// reference, e.g., squid ACL values stored in files
+func Parse(format string, data []byte, readFile func(path string) ([]byte, error)) (Policy, error) {
+	switch format {
+	case FormatSquid:
+		return parseSquid(data, readFile)
+	case FormatDomains:
+		return parseDomains(data)
+	case FormatAWSNetworkFirewall:
+		return parseAWSNetworkFirewall(data)
+	default:
+		return nil, fmt.Errorf("unknown policy format '%s', must be one of %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+// DetectFormat guesses the format of the policy at path from its name: ".json" files are AWS
+// Network Firewall rule groups, ".conf" files are squid configs, and anything else is a domain
+// allowlist
+func DetectFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatAWSNetworkFirewall
+	case ".conf":
+		return FormatSquid
+	default:
+		return FormatDomains
+	}
+}
+
+// Analyze evaluates p against every host and port of egressList, in order. Wildcard endpoints are
+// evaluated against each of their sample hosts, and endpoints that aren't allowed are marked as
+// satisfied by the first member of their anyOf group allowed on the same port, if any
+func Analyze(p Policy, egressList *egress_lists.EgressList) []Result {
+	results := []Result{}
+	for _, endpoint := range egressList.Endpoints {
+		for _, host := range endpoint.Hosts() {
+			for _, port := range endpoint.Ports {
+				req := Request{Host: strings.ToLower(host), Port: port, Protocol: endpoint.ProtocolFor(port), Method: "CONNECT"}
+				if req.Protocol == egress_lists.ProtocolHTTP {
+					req.Method = endpoint.Method
+					if req.Method == "" {
+						req.Method = egress_lists.DefaultMethod
+					}
+					req.Path = endpoint.Path
+				}
+				verdict := p.Evaluate(req)
+				results = append(results, Result{
+					Host:     host,
+					Port:     port,
+					Protocol: req.Protocol,
+					Required: endpoint.IsRequired(),
+					Decision: verdict.Decision,
+					Reason:   verdict.Reason,
+					AnyOf:    endpoint.AnyOf,
+				})
+			}
+		}
+	}
+
+	allowed := map[groupKey]string{}
+	for _, result := range results {
+		key := groupKey{result.AnyOf, result.Port}
+		if result.AnyOf != "" && result.Decision == DecisionAllow && allowed[key] == "" {
+			allowed[key] = net.JoinHostPort(result.Host, strconv.Itoa(result.Port))
+		}
+	}
+	for i := range results {
+		if results[i].Decision != DecisionAllow {
+			results[i].SatisfiedBy = allowed[groupKey{results[i].AnyOf, results[i].Port}]
+		}
+	}
+	return results
+}
+
+// groupKey identifies an anyOf group on a port. Members only stand in for each other on the same port
+type groupKey struct {
+	anyOf string
+	port  int
+}
+
+// matchDomain returns true if host matches pattern: exactly, or as a subdomain of patterns starting
+// with "." (which also match the domain itself) or "*." (which don't)
+func matchDomain(pattern, host string) bool {
+	pattern = strings.ToLower(pattern)
+	switch {
+	case strings.HasPrefix(pattern, "*."):
+		return strings.HasSuffix(host, pattern[1:])
+	case strings.HasPrefix(pattern, "."):
+		return host == pattern[1:] || strings.HasSuffix(host, pattern)
+	default:
+		return host == pattern
+	}
+}
@@ -0,0 +1,170 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+)
+
+func TestAnalyze(t *testing.T) {
+	optional := false
+	egressList := &egress_lists.EgressList{Endpoints: []egress_lists.Endpoint{
+		{Host: "quay.io", Ports: []int{80, 443}},
+		{Host: "*.s3.amazonaws.com", Ports: []int{443}, Samples: []string{"bucket"}},
+		{Host: "console.redhat.com", Ports: []int{443}, Required: &optional},
+		{Host: "mirror-a.example.com", Ports: []int{80, 443}, AnyOf: "mirrors"},
+		{Host: "mirror-b.example.com", Ports: []int{80, 443}, AnyOf: "mirrors"},
+	}}
+	p, err := parseDomains([]byte("quay.io:443\nmirror-b.example.com:443\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Result{
+		{Host: "quay.io", Port: 80, Protocol: "http", Required: true, Decision: DecisionBlock, Reason: "not in the allowlist"},
+		{Host: "quay.io", Port: 443, Protocol: "https", Required: true, Decision: DecisionAllow, Reason: "allowed by 'quay.io:443' (line 1)"},
+		{Host: "bucket.s3.amazonaws.com", Port: 443, Protocol: "https", Required: true, Decision: DecisionBlock, Reason: "not in the allowlist"},
+		{Host: "console.redhat.com", Port: 443, Protocol: "https", Required: false, Decision: DecisionBlock, Reason: "not in the allowlist"},
+		{Host: "mirror-a.example.com", Port: 80, Protocol: "http", Required: true, Decision: DecisionBlock, Reason: "not in the allowlist", AnyOf: "mirrors"},
+		{Host: "mirror-a.example.com", Port: 443, Protocol: "https", Required: true, Decision: DecisionBlock, Reason: "not in the allowlist", AnyOf: "mirrors", SatisfiedBy: "mirror-b.example.com:443"},
+		{Host: "mirror-b.example.com", Port: 80, Protocol: "http", Required: true, Decision: DecisionBlock, Reason: "not in the allowlist", AnyOf: "mirrors"},
+		{Host: "mirror-b.example.com", Port: 443, Protocol: "https", Required: true, Decision: DecisionAllow, Reason: "allowed by 'mirror-b.example.com:443' (line 2)", AnyOf: "mirrors"},
+	}
+	got := Analyze(p, egressList)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if !got[0].Blocked() || got[1].Blocked() || got[3].Blocked() || !got[4].Blocked() || got[5].Blocked() {
+		t.Errorf("expected only blocked required endpoints without allowed alternatives to be Blocked(), got %+v", got)
+	}
+}
+
+func TestParse_Domains(t *testing.T) {
+	p, err := Parse(FormatDomains, []byte(`# Registries
+quay.io
+.redhat.io
+*.amazonaws.com:443
+10.0.0.0/8
+192.168.1.1 # mirror
+`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		req  Request
+		want Decision
+	}{
+		{req: Request{Host: "quay.io", Port: 443}, want: DecisionAllow},
+		{req: Request{Host: "cdn.quay.io", Port: 443}, want: DecisionUnknown},
+		{req: Request{Host: "redhat.io", Port: 443}, want: DecisionAllow},
+		{req: Request{Host: "registry.redhat.io", Port: 80}, want: DecisionAllow},
+		{req: Request{Host: "ec2.us-east-1.amazonaws.com", Port: 443}, want: DecisionAllow},
+		{req: Request{Host: "ec2.us-east-1.amazonaws.com", Port: 80}, want: DecisionUnknown},
+		{req: Request{Host: "amazonaws.com", Port: 443}, want: DecisionUnknown},
+		{req: Request{Host: "10.1.2.3", Port: 9997}, want: DecisionAllow},
+		{req: Request{Host: "192.168.1.1", Port: 443}, want: DecisionAllow},
+		{req: Request{Host: "192.168.1.2", Port: 443}, want: DecisionBlock},
+	}
+	for _, tt := range tests {
+		if got := p.Evaluate(tt.req); got.Decision != tt.want {
+			t.Errorf("Evaluate(%+v) = %+v, want %s", tt.req, got, tt.want)
+		}
+	}
+
+	// Without network entries, hostnames no domain matches are blocked
+	p, err = Parse(FormatDomains, []byte("quay.io\n10.0.0.0/8:9997\n"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.Evaluate(Request{Host: "cdn.quay.io", Port: 443}); got.Decision != DecisionBlock {
+		t.Errorf("expected cdn.quay.io:443 to be blocked, got %+v", got)
+	}
+	want := "not allowed by any domain, but '10.0.0.0/8:9997' (line 2) may allow the addresses it resolves to, which can't be evaluated statically"
+	if got := p.Evaluate(Request{Host: "cdn.quay.io", Port: 9997}); got.Decision != DecisionUnknown || got.Reason != want {
+		t.Errorf("expected cdn.quay.io:9997 to be unknown because of '10.0.0.0/8:9997', got %+v", got)
+	}
+
+	if _, err := Parse(FormatDomains, []byte("quay.io:99999\n"), nil); err == nil {
+		t.Error("expected an error for an invalid port")
+	}
+}
+
+func TestParse_AWSNetworkFirewall(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		req     Request
+		want    Decision
+		wantErr bool
+	}{
+		{
+			name:   "allowlisted SNI",
+			policy: `{"RulesSource": {"RulesSourceList": {"Targets": [".quay.io"], "TargetTypes": ["TLS_SNI"], "GeneratedRulesType": "ALLOWLIST"}}}`,
+			req:    Request{Host: "cdn.quay.io", Port: 443, Protocol: egress_lists.ProtocolHTTPS},
+			want:   DecisionAllow,
+		},
+		{
+			name:   "SNI missing from allowlist",
+			policy: `{"RulesSource": {"RulesSourceList": {"Targets": [".quay.io"], "TargetTypes": ["TLS_SNI"], "GeneratedRulesType": "ALLOWLIST"}}}`,
+			req:    Request{Host: "registry.redhat.io", Port: 443, Protocol: egress_lists.ProtocolTLS},
+			want:   DecisionBlock,
+		},
+		{
+			name:   "uninspected HTTP",
+			policy: `{"RulesSource": {"RulesSourceList": {"Targets": [".quay.io"], "TargetTypes": ["TLS_SNI"], "GeneratedRulesType": "ALLOWLIST"}}}`,
+			req:    Request{Host: "quay.io", Port: 80, Protocol: egress_lists.ProtocolHTTP},
+			want:   DecisionUnknown,
+		},
+		{
+			name:   "plain TCP",
+			policy: `{"RulesSource": {"RulesSourceList": {"Targets": [".quay.io"], "TargetTypes": ["TLS_SNI"], "GeneratedRulesType": "ALLOWLIST"}}}`,
+			req:    Request{Host: "quay.io", Port: 9997, Protocol: egress_lists.ProtocolTCP},
+			want:   DecisionUnknown,
+		},
+		{
+			name:   "denylisted host in describe-rule-group output",
+			policy: `{"RuleGroup": {"RulesSource": {"RulesSourceList": {"Targets": ["quay.io"], "TargetTypes": ["HTTP_HOST"], "GeneratedRulesType": "DENYLIST"}}}}`,
+			req:    Request{Host: "quay.io", Port: 80, Protocol: egress_lists.ProtocolHTTP},
+			want:   DecisionBlock,
+		},
+		{
+			name:    "stateless rule group",
+			policy:  `{"RulesSource": {"StatelessRulesAndCustomActions": {}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown target type",
+			policy:  `{"RulesSource": {"RulesSourceList": {"Targets": ["quay.io"], "TargetTypes": ["DNS"], "GeneratedRulesType": "ALLOWLIST"}}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(FormatAWSNetworkFirewall, []byte(tt.policy), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := p.Evaluate(tt.req); got.Decision != tt.want {
+				t.Errorf("got %+v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	for path, want := range map[string]string{
+		"/etc/squid/squid.conf": FormatSquid,
+		"rule-group.JSON":       FormatAWSNetworkFirewall,
+		"allowlist.txt":         FormatDomains,
+	} {
+		if got := DetectFormat(path); got != want {
+			t.Errorf("DetectFormat(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
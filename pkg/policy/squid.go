@@ -0,0 +1,312 @@
+package policy
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// squidMatch is the outcome of matching a squid ACL against a request. ACLs that depend on facts a
+// static analysis can't know (e.g., the addresses a hostname resolves to) match unknown
+type squidMatch int
+
+const (
+	squidNoMatch squidMatch = iota
+	squidMatched
+	squidUnknown
+)
+
+// squidACL is a named squid ACL, built from every "acl" directive with its name
+type squidACL struct {
+	name    string
+	aclType string
+	values  []string
+	regexps []*regexp.Regexp
+	// builtin ACLs are predefined by squid, which ignores directives redefining them
+	builtin bool
+}
+
+// squidBuiltinACLs are the ACLs squid (3.2 and later) predefines, and which the stock squid.conf
+// uses without defining
+var squidBuiltinACLs = [][]string{
+	{"all", "src", "all"},
+	{"localhost", "src", "127.0.0.1/32", "::1"},
+	{"to_localhost", "dst", "127.0.0.0/8", "0.0.0.0/32", "::1/128", "::/128"},
+	{"manager", "url_regex", "-i", "^cache_object://", "+i", "^https?://[^/]+/squid-internal-mgr/"},
+}
+
+// squidACLRef is a reference to an ACL in an "http_access" rule
+type squidACLRef struct {
+	acl     *squidACL
+	negated bool
+}
+
+// squidAccessRule is a single "http_access" directive
+type squidAccessRule struct {
+	allow bool
+	acls  []squidACLRef
+	line  int
+	text  string
+}
+
+// squidPolicy evaluates requests like squid evaluates http_access rules: the first rule whose ACLs
+// all match decides, and if none match, the opposite of the last rule applies
+type squidPolicy struct {
+	acls  map[string]*squidACL
+	rules []squidAccessRule
+}
+
+// parseSquid parses the "acl" and "http_access" directives of a squid.conf, ignoring all others.
+// ACL values in quoted file names (e.g., acl allowed dstdomain "/etc/squid/allowed.txt") are read
+// with readFile
+func parseSquid(data []byte, readFile func(path string) ([]byte, error)) (*squidPolicy, error) {
+	p := &squidPolicy{acls: map[string]*squidACL{}}
+	for _, fields := range squidBuiltinACLs {
+		if err := p.parseACL(fields, nil); err != nil {
+			return nil, err
+		}
+		p.acls[fields[0]].builtin = true
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		// Comments may also follow directives, e.g., "acl Safe_ports port 80 # http"
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var err error
+		switch fields[0] {
+		case "acl":
+			err = p.parseACL(fields[1:], readFile)
+		case "http_access":
+			err = p.parseAccessRule(fields[1:], line, strings.Join(fields, " "))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return p, scanner.Err()
+}
+
+func (p *squidPolicy) parseACL(fields []string, readFile func(path string) ([]byte, error)) error {
+	if len(fields) < 2 {
+		return fmt.Errorf("acl requires a name and a type")
+	}
+	name, aclType := fields[0], fields[1]
+	acl, ok := p.acls[name]
+	if !ok {
+		acl = &squidACL{name: name, aclType: aclType}
+		p.acls[name] = acl
+	} else if acl.builtin {
+		return nil
+	} else if acl.aclType != aclType {
+		return fmt.Errorf("acl '%s' is already defined with type '%s'", name, acl.aclType)
+	}
+
+	caseInsensitive := false
+	for _, value := range fields[2:] {
+		switch {
+		case value == "-i" || value == "+i":
+			caseInsensitive = value == "-i"
+			continue
+		case strings.HasPrefix(value, "-"):
+			// Other flags, e.g., -n, don't affect matching
+			continue
+		case strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) && len(value) > 1:
+			values, err := readACLFile(strings.Trim(value, `"`), readFile)
+			if err != nil {
+				return err
+			}
+			acl.values = append(acl.values, values...)
+		default:
+			acl.values = append(acl.values, value)
+		}
+	}
+	if strings.HasSuffix(aclType, "_regex") {
+		// Regexes are compiled once every value of this directive has been read
+		for _, value := range acl.values[len(acl.regexps):] {
+			if caseInsensitive {
+				value = "(?i)" + value
+			}
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return fmt.Errorf("invalid regex in acl '%s': %w", name, err)
+			}
+			acl.regexps = append(acl.regexps, re)
+		}
+	}
+	return nil
+}
+
+// readACLFile returns the ACL values in the file at path, one per line, ignoring "#" comments
+func readACLFile(path string, readFile func(path string) ([]byte, error)) ([]string, error) {
+	data, err := readFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read acl values: %w", err)
+	}
+	var values []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		values = append(values, strings.Fields(line)...)
+	}
+	return values, nil
+}
+
+func (p *squidPolicy) parseAccessRule(fields []string, line int, text string) error {
+	if len(fields) < 2 || (fields[0] != "allow" && fields[0] != "deny") {
+		return fmt.Errorf("http_access requires 'allow' or 'deny' followed by acl names")
+	}
+	rule := squidAccessRule{allow: fields[0] == "allow", line: line, text: text}
+	for _, name := range fields[1:] {
+		negated := strings.HasPrefix(name, "!")
+		name = strings.TrimPrefix(name, "!")
+		acl, ok := p.acls[name]
+		if !ok {
+			return fmt.Errorf("http_access references undefined acl '%s'", name)
+		}
+		rule.acls = append(rule.acls, squidACLRef{acl: acl, negated: negated})
+	}
+	p.rules = append(p.rules, rule)
+	return nil
+}
+
+func (p *squidPolicy) Evaluate(req Request) Verdict {
+	for _, rule := range p.rules {
+		match := squidMatched
+		var unknownACL *squidACL
+		for _, ref := range rule.acls {
+			m := ref.acl.match(req)
+			if ref.negated && m == squidMatched {
+				m = squidNoMatch
+			} else if ref.negated && m == squidNoMatch {
+				m = squidMatched
+			}
+			if m == squidNoMatch {
+				match = squidNoMatch
+				break
+			}
+			if m == squidUnknown && unknownACL == nil {
+				match, unknownACL = squidUnknown, ref.acl
+			}
+		}
+
+		switch match {
+		case squidMatched:
+			decision := DecisionBlock
+			if rule.allow {
+				decision = DecisionAllow
+			}
+			return Verdict{Decision: decision, Reason: fmt.Sprintf("'%s' (line %d)", rule.text, rule.line)}
+		case squidUnknown:
+			return Verdict{Decision: DecisionUnknown, Reason: fmt.Sprintf("'%s' (line %d) depends on %s acl '%s', which can't be evaluated statically", rule.text, rule.line, unknownACL.aclType, unknownACL.name)}
+		}
+	}
+
+	if len(p.rules) == 0 {
+		return Verdict{Decision: DecisionBlock, Reason: "no http_access rules"}
+	}
+	last := p.rules[len(p.rules)-1]
+	decision := DecisionAllow
+	if last.allow {
+		decision = DecisionBlock
+	}
+	return Verdict{Decision: decision, Reason: fmt.Sprintf("no http_access rule matched, so the opposite of the last rule '%s' (line %d) applies", last.text, last.line)}
+}
+
+// match returns whether the ACL matches req. Client ACLs (src) are assumed to match the cluster,
+// unless they only hold loopback addresses
+func (acl *squidACL) match(req Request) squidMatch {
+	switch acl.aclType {
+	case "src":
+		if acl.loopbackOnly() {
+			return squidNoMatch
+		}
+		return squidMatched
+	case "dstdomain", "ssl::server_name":
+		for _, value := range acl.values {
+			if matchDomain(value, req.Host) {
+				return squidMatched
+			}
+		}
+		return squidNoMatch
+	case "dstdom_regex", "ssl::server_name_regex":
+		for _, re := range acl.regexps {
+			if re.MatchString(req.Host) {
+				return squidMatched
+			}
+		}
+		return squidNoMatch
+	case "dst":
+		ip := net.ParseIP(req.Host)
+		if ip == nil && acl.loopbackOnly() {
+			// Egress endpoints never resolve to loopback addresses
+			return squidNoMatch
+		}
+		if ip == nil {
+			// Squid matches the addresses the host resolves to
+			return squidUnknown
+		}
+		for _, value := range acl.values {
+			if value == "all" {
+				return squidMatched
+			}
+			if _, network, err := net.ParseCIDR(value); err == nil && network.Contains(ip) {
+				return squidMatched
+			}
+			if other := net.ParseIP(value); other != nil && other.Equal(ip) {
+				return squidMatched
+			}
+		}
+		return squidNoMatch
+	case "url_regex":
+		for _, re := range acl.regexps {
+			if re.MatchString(req.url()) {
+				return squidMatched
+			}
+		}
+		return squidNoMatch
+	case "port":
+		for _, value := range acl.values {
+			low, high, isRange := strings.Cut(value, "-")
+			if !isRange {
+				high = low
+			}
+			lowPort, lowErr := strconv.Atoi(low)
+			highPort, highErr := strconv.Atoi(high)
+			if lowErr == nil && highErr == nil && req.Port >= lowPort && req.Port <= highPort {
+				return squidMatched
+			}
+		}
+		return squidNoMatch
+	case "method":
+		for _, value := range acl.values {
+			if value == req.Method {
+				return squidMatched
+			}
+		}
+		return squidNoMatch
+	default:
+		return squidUnknown
+	}
+}
+
+// loopbackOnly returns true if the ACL's values are all loopback or unspecified addresses (or
+// networks thereof), e.g., the builtin localhost and to_localhost ACLs
+func (acl *squidACL) loopbackOnly() bool {
+	for _, value := range acl.values {
+		ip := net.ParseIP(value)
+		if _, network, err := net.ParseCIDR(value); err == nil {
+			ip = network.IP
+		}
+		if ip == nil || !(ip.IsLoopback() || ip.IsUnspecified()) {
+			return false
+		}
+	}
+	return len(acl.values) > 0
+}
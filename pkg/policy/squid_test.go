@@ -0,0 +1,189 @@
+package policy
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestSquidPolicy_Evaluate(t *testing.T) {
+	files := map[string]string{
+		"/etc/squid/allowed.txt": "# Red Hat\n.redhat.io\nsso.redhat.com\n",
+	}
+	readFile := func(path string) ([]byte, error) {
+		if content, ok := files[path]; ok {
+			return []byte(content), nil
+		}
+		return nil, os.ErrNotExist
+	}
+	p, err := Parse(FormatSquid, []byte(`
+acl localnet src 10.0.0.0/8
+acl SSL_ports port 443
+acl Safe_ports port 80 443
+acl Safe_ports port 1025-65535
+acl CONNECT method CONNECT
+acl allowed dstdomain .quay.io
+acl allowed dstdomain "/etc/squid/allowed.txt"
+acl aws dstdom_regex -i \.AMAZONAWS\.com$
+acl mirrors dst 192.168.0.0/16
+http_access deny !Safe_ports
+http_access deny CONNECT !SSL_ports !mirrors
+http_access allow localnet allowed
+http_access allow aws
+http_access allow mirrors
+`), readFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  Request
+		want Verdict
+	}{
+		{
+			name: "allowed domain",
+			req:  Request{Host: "cdn.quay.io", Port: 443, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionAllow, Reason: "'http_access allow localnet allowed' (line 13)"},
+		},
+		{
+			name: "domain from acl file",
+			req:  Request{Host: "sso.redhat.com", Port: 443, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionAllow, Reason: "'http_access allow localnet allowed' (line 13)"},
+		},
+		{
+			name: "regex",
+			req:  Request{Host: "ec2.us-east-1.amazonaws.com", Port: 443, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionAllow, Reason: "'http_access allow aws' (line 14)"},
+		},
+		{
+			name: "unsafe port",
+			req:  Request{Host: "quay.io", Port: 22, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionBlock, Reason: "'http_access deny !Safe_ports' (line 11)"},
+		},
+		{
+			name: "CONNECT to a non-SSL port",
+			req:  Request{Host: "quay.io", Port: 9997, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionUnknown, Reason: "'http_access deny CONNECT !SSL_ports !mirrors' (line 12) depends on dst acl 'mirrors', which can't be evaluated statically"},
+		},
+		{
+			name: "CONNECT to a non-SSL port of a mirror address",
+			req:  Request{Host: "192.168.1.1", Port: 9997, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionAllow, Reason: "'http_access allow mirrors' (line 15)"},
+		},
+		{
+			name: "no matching rule",
+			req:  Request{Host: "www.example.com", Port: 80, Method: "HEAD"},
+			want: Verdict{Decision: DecisionUnknown, Reason: "'http_access allow mirrors' (line 15) depends on dst acl 'mirrors', which can't be evaluated statically"},
+		},
+		{
+			name: "no matching rule for an address",
+			req:  Request{Host: "172.16.0.1", Port: 80, Method: "HEAD"},
+			want: Verdict{Decision: DecisionBlock, Reason: "no http_access rule matched, so the opposite of the last rule 'http_access allow mirrors' (line 15) applies"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Evaluate(tt.req); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// stockSquidConf holds the access control directives of the squid.conf shipped with squid, which
+// rely on the builtin localhost and manager ACLs
+const stockSquidConf = `
+acl localnet src 0.0.0.1-0.255.255.255	# RFC 1122 "this" network (LAN)
+acl localnet src 10.0.0.0/8		# RFC 1918 local private network (LAN)
+acl localnet src 172.16.0.0/12		# RFC 1918 local private network (LAN)
+acl localnet src 192.168.0.0/16		# RFC 1918 local private network (LAN)
+acl localnet src fc00::/7       	# RFC 4193 local private network range
+
+acl SSL_ports port 443
+acl Safe_ports port 80		# http
+acl Safe_ports port 21		# ftp
+acl Safe_ports port 443		# https
+acl Safe_ports port 1025-65535	# unregistered ports
+acl CONNECT method CONNECT
+
+# Deny requests to certain unsafe ports
+http_access deny !Safe_ports
+http_access deny CONNECT !SSL_ports
+http_access allow localhost manager
+http_access deny manager
+http_access deny to_localhost
+http_access allow localnet
+http_access allow localhost
+http_access deny all
+http_port 3128
+`
+
+func TestSquidPolicy_EvaluateStockConfig(t *testing.T) {
+	p, err := Parse(FormatSquid, []byte(stockSquidConf), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  Request
+		want Verdict
+	}{
+		{
+			name: "CONNECT",
+			req:  Request{Host: "quay.io", Port: 443, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionAllow, Reason: "'http_access allow localnet' (line 21)"},
+		},
+		{
+			name: "HEAD",
+			req:  Request{Host: "quay.io", Port: 80, Method: "HEAD"},
+			want: Verdict{Decision: DecisionAllow, Reason: "'http_access allow localnet' (line 21)"},
+		},
+		{
+			name: "cache manager",
+			req:  Request{Host: "proxy.example.com", Port: 3128, Method: "GET", Path: "/squid-internal-mgr/info"},
+			want: Verdict{Decision: DecisionBlock, Reason: "'http_access deny manager' (line 19)"},
+		},
+		{
+			name: "loopback address",
+			req:  Request{Host: "127.0.0.1", Port: 443, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionBlock, Reason: "'http_access deny to_localhost' (line 20)"},
+		},
+		{
+			name: "CONNECT to a non-SSL port",
+			req:  Request{Host: "inputs1.osdsecuritylogs.splunkcloud.com", Port: 9997, Method: "CONNECT"},
+			want: Verdict{Decision: DecisionBlock, Reason: "'http_access deny CONNECT !SSL_ports' (line 17)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Evaluate(tt.req); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_SquidErrors(t *testing.T) {
+	readFile := func(string) ([]byte, error) { return nil, errors.New("no such file") }
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{name: "undefined acl", policy: "http_access allow allowed\n"},
+		{name: "conflicting acl types", policy: "acl allowed dstdomain quay.io\nacl allowed port 443\n"},
+		{name: "invalid regex", policy: "acl allowed dstdom_regex (\n"},
+		{name: "missing acl file", policy: "acl allowed dstdomain \"/etc/squid/missing.txt\"\n"},
+		{name: "invalid http_access", policy: "http_access permit all\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(FormatSquid, []byte(tt.policy), readFile); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
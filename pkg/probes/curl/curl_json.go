@@ -4,8 +4,9 @@ import (
 	_ "embed"
 	"encoding/base64"
 	"fmt"
-	"net"
+	"math"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,9 +15,11 @@ import (
 	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
 
 	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
 	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
 	"github.com/openshift/osd-network-verifier/pkg/helpers"
 	"github.com/openshift/osd-network-verifier/pkg/output"
+	"github.com/openshift/osd-network-verifier/pkg/probes"
 )
 
 // curl.Probe is an implementation of the probes.Probe interface that uses the venerable curl tool to
@@ -45,6 +48,20 @@ var presetUserDataVariables = map[string]string{
 	"LINE_PREFIX":    outputLinePrefix,
 }
 
+func init() {
+	probes.Register(probes.Registration{
+		Name:    "curl",
+		Aliases: []string{"curlprobe", "curl.probe"},
+		New:     func() probes.Probe { return Probe{} },
+		Capabilities: probes.Capabilities{
+			Platforms:         []cloud.Platform{cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress, cloud.GCPClassic},
+			Architectures:     []cpu.Architecture{cpu.ArchX86, cpu.ArchARM},
+			CustomEgressLists: true,
+			Proxies:           true,
+		},
+	})
+}
+
 // GetStartingToken returns the string token used to signal the beginning of the probe's output
 func (clp Probe) GetStartingToken() string { return startingToken }
 
@@ -55,7 +72,7 @@ func (clp Probe) GetEndingToken() string { return endingToken }
 func (clp Probe) GetMachineImageID(platformType cloud.Platform, cpuArch cpu.Architecture, region string) (string, error) {
 	//Validate platformType
 	if !platformType.IsValid() {
-		return "", handledErrors.NewGenericError(fmt.Errorf("invalid platform type specified %s", platformType))
+		return "", handledErrors.NewGenericError(fmt.Errorf("invalid platform type specified %s", platformType)).WithCode(handledErrors.CodeInvalidConfiguration)
 	}
 
 	if platformType == cloud.AWSHCP || platformType == cloud.AWSHCPZeroEgress {
@@ -166,19 +183,31 @@ func (clp Probe) GetExpandedUserData(userDataVariables map[string]string) (strin
 		}
 	}
 
+	// URLs prefixed with request options (see egress_lists.ParseURLEntry) can't be requested with
+	// the default flags, so they're split out and requested in groups of their own below
+	var urlGroups, tlsDisabledURLGroups []*urlGroup
+	userDataVariables["URLS"], urlGroups = groupURLs(userDataVariables["URLS"], userDataVariables["TIMEOUT"], false)
+	userDataVariables["TLSDISABLED_URLS"], tlsDisabledURLGroups = groupURLs(userDataVariables["TLSDISABLED_URLS"], userDataVariables["TIMEOUT"], true)
+
 	// Assuming NOTLS=false, "tlsDisabled" URLs must have curl's "--insecure" flag applied *only* to them.
 	// We use curl's "parser reset" flag ("--next" or "-:") to do this, but this has the unfortunate side
 	// effect of forcing us to re-pass most curl flags (except global flags and those irrelevant to HTTPS)
 	if userDataVariables["TLSDISABLED_URLS"] != "" {
-		userDataVariables["TLSDISABLED_URLS_RENDERED"] = fmt.Sprintf(
-			// TODO consider a better way of keeping this in sync with what's in userdata-template.yaml?
-			`--next -k --retry 3 --retry-connrefused -s -I -m %s -w "%%{stderr}%s%%{json}\n" %s %s --proto =https`,
-			userDataVariables["TIMEOUT"],
-			outputLinePrefix,
-			userDataVariables["CURLOPT"],
-			userDataVariables["TLSDISABLED_URLS"],
-		)
+		tlsDisabledURLGroups = append([]*urlGroup{{
+			method:   egress_lists.DefaultMethod,
+			timeout:  userDataVariables["TIMEOUT"],
+			retries:  defaultRetries,
+			insecure: true,
+			urls:     strings.Fields(userDataVariables["TLSDISABLED_URLS"]),
+		}}, tlsDisabledURLGroups...)
+	}
+
+	// Each group is requested in its own parser-reset group
+	var renderedGroups []string
+	for _, group := range append(urlGroups, tlsDisabledURLGroups...) {
+		renderedGroups = append(renderedGroups, group.render(userDataVariables["CURLOPT"]))
 	}
+	userDataVariables["GROUPED_URLS_RENDERED"] = strings.Join(renderedGroups, " ")
 
 	// Expand template
 	return os.Expand(directivelessUserDataTemplate, func(userDataVar string) string {
@@ -189,40 +218,98 @@ func (clp Probe) GetExpandedUserData(userDataVariables map[string]string) (strin
 	}), nil
 }
 
+// defaultRetries is the number of times curl retries reaching URLs that don't declare otherwise
+const defaultRetries = 3
+
+// urlGroup is a set of URLs requested with the same curl flags
+type urlGroup struct {
+	method   string
+	timeout  string
+	retries  int
+	insecure bool
+	urls     []string
+}
+
+// render returns the curl flags requesting the group's URLs in a parser-reset ("--next") group of
+// their own. URLs requested with egress_lists.DefaultMethod only ask for the response's headers,
+// while those requested with other methods discard the response body
+func (g *urlGroup) render(curlopt string) string {
+	flags := ""
+	if g.insecure {
+		flags = "-k "
+	}
+	request, protocols := "-t B -s -I", "=http,https,telnet"
+	switch {
+	case g.method != egress_lists.DefaultMethod:
+		request, protocols = fmt.Sprintf("-X %s -s -o /dev/null", g.method), "=http,https"
+	case g.insecure:
+		request, protocols = "-s -I", "=https"
+	}
+	return fmt.Sprintf(
+		`--next %s%s --retry %d --retry-connrefused -m %s -w "%%{stderr}%s%%{json}\n" %s %s --proto %s`,
+		flags,
+		request,
+		g.retries,
+		g.timeout,
+		outputLinePrefix,
+		curlopt,
+		strings.Join(g.urls, " "),
+		protocols,
+	)
+}
+
+// groupURLs splits a space-separated list of URL entries into those requested with the default
+// method, timeout and retry count, which are returned as a space-separated list, and groups of the
+// URLs requested with other options (see egress_lists.ParseURLEntry), in order of appearance.
+// timeout is the default timeout, already normalized for curl
+func groupURLs(urls string, timeout string, insecure bool) (string, []*urlGroup) {
+	var defaultURLs []string
+	var groups []*urlGroup
+	for _, entry := range strings.Fields(urls) {
+		parsed := egress_lists.ParseURLEntry(entry)
+		group := urlGroup{method: parsed.Method, timeout: timeout, retries: defaultRetries, insecure: insecure}
+		if parsed.Timeout > 0 {
+			// Round up, so that timeouts below curl's precision don't become "-m 0.00" (no timeout)
+			group.timeout = fmt.Sprintf("%.2f", math.Ceil(parsed.Timeout.Seconds()*100)/100)
+		}
+		if parsed.Retries != nil {
+			group.retries = *parsed.Retries
+		}
+		if group.method == egress_lists.DefaultMethod && group.timeout == timeout && group.retries == defaultRetries {
+			defaultURLs = append(defaultURLs, parsed.URL)
+			continue
+		}
+
+		i := slices.IndexFunc(groups, func(other *urlGroup) bool {
+			return other.method == group.method && other.timeout == group.timeout && other.retries == group.retries
+		})
+		if i < 0 {
+			i = len(groups)
+			groups = append(groups, &group)
+		}
+		groups[i].urls = append(groups[i].urls, parsed.URL)
+	}
+	return strings.Join(defaultURLs, " "), groups
+}
+
 // ParseProbeOutput accepts a string containing all probe output that appeared between
 // the startingToken and the endingToken and a pointer to an Output object. outputDestination
-// will be filled with the results from the egress check
-// When ensurePrivate is set to true, will not only check the endpoint is accessible, but also ensure the endpoint is private
-func (clp Probe) ParseProbeOutput(ensurePrivate bool, probeOutput string, outputDestination *output.Output) {
+// will be filled with the results from the egress check. Whether each endpoint was reached at an
+// expected address is checked later, against the egress list (see verifier.AnnotateEndpoints)
+func (clp Probe) ParseProbeOutput(probeOutput string, outputDestination *output.Output) {
 	// probeOutput first needs to be "repaired" due to curl and AWS bugs
 	repairedProbeOutput := helpers.FixLeadingZerosInJSON(helpers.RemoveTimestamps(probeOutput))
 	probeResults, errMap := bulkDeserializeCurlJSONProbeResult(repairedProbeOutput)
 	for _, probeResult := range probeResults {
 		outputDestination.AddDebugLogs(fmt.Sprintf("%+v\n", probeResult))
-		if !probeResult.IsSuccessfulConnection() {
-			// Replace "telnet" with "tcp" in output to prevent confusion over a probe
-			// implementation detail
-			url := strings.Replace(probeResult.URL, "telnet", "tcp", 1)
-			outputDestination.SetEgressFailures(
-				[]string{fmt.Sprintf("%s (%s)", url, probeResult.ErrorMsg)},
-			)
-		}
-		// when ensurePrivate is set to true, we need to make sure the returned IP address is private
-		if ensurePrivate {
-			remoteIP := net.ParseIP(probeResult.RemoteIP)
-			if !remoteIP.IsPrivate() {
-				probeResult.ErrorMsg = "The endpoint is non private"
-				url := strings.Replace(probeResult.URL, "telnet", "tcp", 1)
-				outputDestination.SetEgressFailures(
-					[]string{fmt.Sprintf("%s (%s)", url, probeResult.ErrorMsg)})
-			}
-		}
+		// Every endpoint is recorded; failed ones are reported as egress failures by the output
+		outputDestination.AddEndpointResult(probeResult.EndpointResult())
 	}
 	for lineNum, err := range errMap {
 		outputDestination.AddError(
 			handledErrors.NewGenericError(
 				fmt.Errorf("error processing line %d: %w", lineNum, err),
-			),
+			).WithCode(handledErrors.CodeProbeCorrupt),
 		)
 	}
 }
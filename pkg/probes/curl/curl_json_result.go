@@ -3,7 +3,12 @@ package curl
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+
+	"github.com/openshift/osd-network-verifier/pkg/output"
 )
 
 // A CurlJSONProbeResult represents all the data the curl probe had to offer regarding its
@@ -50,6 +55,8 @@ type CurlJSONProbeResult struct {
 	URLEffective         string  `json:"url_effective"`
 	URLNum               int     `json:"urlnum"`
 	CurlVersion          string  `json:"curl_version"`
+	// NumRetries is only reported by curl v8.9.0 and later
+	NumRetries *int `json:"num_retries"`
 }
 
 // IsSuccessfulConnection returns true if the CurlJSONProbeResult reports a successful
@@ -80,6 +87,103 @@ func (res CurlJSONProbeResult) IsSuccessfulConnection() bool {
 	return false
 }
 
+// FailureCategory classifies why curl failed to connect, based on its exit code and (for proxied
+// requests) the status code of the proxy's response to CONNECT. Returns output.FailureCategoryNone
+// if IsSuccessfulConnection() is true. See https://curl.se/libcurl/c/libcurl-errors.html for the
+// meaning of each exit code
+func (res CurlJSONProbeResult) FailureCategory() output.FailureCategory {
+	if res.IsSuccessfulConnection() {
+		return output.FailureCategoryNone
+	}
+
+	// The proxy rejected the CONNECT request (e.g., 403 Forbidden or 407 Proxy Authentication
+	// Required), regardless of how curl chose to report it
+	if res.HTTPConnect >= 400 {
+		return output.FailureCategoryProxy
+	}
+
+	switch res.ExitCode {
+	case 6: // CURLE_COULDNT_RESOLVE_HOST
+		return output.FailureCategoryDNS
+	case 5, 97: // CURLE_COULDNT_RESOLVE_PROXY, CURLE_PROXY
+		return output.FailureCategoryProxy
+	case 7: // CURLE_COULDNT_CONNECT
+		return output.FailureCategoryConnectionRefused
+	case 28: // CURLE_OPERATION_TIMEDOUT
+		return output.FailureCategoryTimeout
+	case 52, 55, 56: // CURLE_GOT_NOTHING, CURLE_SEND_ERROR, CURLE_RECV_ERROR
+		return output.FailureCategoryConnectionReset
+	case 35: // CURLE_SSL_CONNECT_ERROR
+		return output.FailureCategoryTLSHandshake
+	case 51, 58, 60, 77, 83, 90, 91: // Certificate, CA bundle, pinning and OCSP errors
+		return output.FailureCategoryCertificate
+	default:
+		return output.FailureCategoryUnreachable
+	}
+}
+
+// curlVersionRegexp captures the major and minor versions of libcurl from CurlVersion
+var curlVersionRegexp = regexp.MustCompile(`^libcurl/([0-9]+)\.([0-9]+)`)
+
+// reportsRetries returns whether the version of curl that produced the result reports NumRetries,
+// which is the case for v8.9.0 and later
+func (res CurlJSONProbeResult) reportsRetries() bool {
+	match := curlVersionRegexp.FindStringSubmatch(res.CurlVersion)
+	if match == nil {
+		return false
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major > 8 || (major == 8 && minor >= 9)
+}
+
+// EndpointResult converts the CurlJSONProbeResult into a probe-agnostic output.EndpointResult.
+// The returned result's Status only reflects whether curl was able to connect to the endpoint.
+// Note that "telnet" is replaced with "tcp" in the returned scheme and URL to prevent confusion
+// over a probe implementation detail
+func (res CurlJSONProbeResult) EndpointResult() output.EndpointResult {
+	result := output.EndpointResult{
+		URL:          strings.Replace(res.URL, "telnet", "tcp", 1),
+		Scheme:       strings.Replace(strings.ToLower(res.Scheme), "telnet", "tcp", 1),
+		RemoteIP:     res.RemoteIP,
+		CurlExitCode: res.ExitCode,
+		HTTPCode:     res.HTTPCode,
+		Timings: output.EndpointTimings{
+			NameLookup:    res.TimeNameLookup,
+			Connect:       res.TimeConnect,
+			AppConnect:    res.TimeAppConnect,
+			PreTransfer:   res.TimePreTransfer,
+			StartTransfer: res.TimeStartTransfer,
+			Total:         res.TimeTotal,
+		},
+		Status: output.EndpointPassed,
+	}
+	if res.NumRetries != nil && res.reportsRetries() {
+		result.Attempts = *res.NumRetries + 1
+	}
+
+	// Curl leaves some fields (e.g., scheme) empty when it fails early, so take the host and
+	// port from the URL we asked it to reach
+	if parsedURL, err := url.Parse(res.URL); err == nil {
+		result.Host = parsedURL.Hostname()
+		result.Port, _ = strconv.Atoi(parsedURL.Port())
+		if result.Scheme == "" {
+			result.Scheme = strings.Replace(parsedURL.Scheme, "telnet", "tcp", 1)
+		}
+	}
+	if result.Port == 0 {
+		result.Port = res.RemotePort
+	}
+
+	if !res.IsSuccessfulConnection() {
+		result.Status = output.EndpointFailed
+		result.Category = res.FailureCategory()
+		result.Message = res.ErrorMsg
+	}
+
+	return result
+}
+
 // bulkDeserializeCurlJSONProbeResult wraps deserializeCurlJSONProbeResult, creating a
 // CurlJSONProbeResult from a each line (containing prefixed JSON) of the provided
 // string. A slice of successfully-deserialized CurlJSONProbeResult-pointers is returned
@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"slices"
 	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/output"
 )
 
 func TestCurlJSONProbeResult_isSuccessfulConnection(t *testing.T) {
@@ -367,3 +369,174 @@ func mapKeys[M ~map[K]V, K comparable, V any](m M) []K {
 	}
 	return r
 }
+
+func TestCurlJSONProbeResult_FailureCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		res  CurlJSONProbeResult
+		want output.FailureCategory
+	}{
+		{
+			name: "success",
+			res:  CurlJSONProbeResult{Scheme: "HTTPS", ExitCode: 0},
+			want: output.FailureCategoryNone,
+		},
+		{
+			name: "successful telnet",
+			res:  CurlJSONProbeResult{Scheme: "TELNET", ExitCode: 49},
+			want: output.FailureCategoryNone,
+		},
+		{
+			name: "dns resolution",
+			res:  CurlJSONProbeResult{ExitCode: 6},
+			want: output.FailureCategoryDNS,
+		},
+		{
+			name: "connection refused",
+			res:  CurlJSONProbeResult{ExitCode: 7},
+			want: output.FailureCategoryConnectionRefused,
+		},
+		{
+			name: "timeout",
+			res:  CurlJSONProbeResult{ExitCode: 28},
+			want: output.FailureCategoryTimeout,
+		},
+		{
+			name: "tls handshake",
+			res:  CurlJSONProbeResult{Scheme: "HTTPS", ExitCode: 35},
+			want: output.FailureCategoryTLSHandshake,
+		},
+		{
+			name: "certificate verification",
+			res:  CurlJSONProbeResult{Scheme: "HTTPS", ExitCode: 60},
+			want: output.FailureCategoryCertificate,
+		},
+		{
+			name: "connection reset",
+			res:  CurlJSONProbeResult{Scheme: "HTTPS", ExitCode: 56},
+			want: output.FailureCategoryConnectionReset,
+		},
+		{
+			name: "proxy rejected CONNECT",
+			res:  CurlJSONProbeResult{ExitCode: 56, HTTPConnect: 403},
+			want: output.FailureCategoryProxy,
+		},
+		{
+			name: "proxy authentication required",
+			res:  CurlJSONProbeResult{ExitCode: 56, HTTPConnect: 407},
+			want: output.FailureCategoryProxy,
+		},
+		{
+			name: "unresolvable proxy",
+			res:  CurlJSONProbeResult{ExitCode: 5},
+			want: output.FailureCategoryProxy,
+		},
+		{
+			name: "telnet hack with unexpected exit code",
+			res:  CurlJSONProbeResult{Scheme: "TELNET", ExitCode: 0},
+			want: output.FailureCategoryUnreachable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.FailureCategory(); got != tt.want {
+				t.Errorf("FailureCategory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurlJSONProbeResult_EndpointResult(t *testing.T) {
+	retries := 2
+	tests := []struct {
+		name string
+		res  CurlJSONProbeResult
+		want output.EndpointResult
+	}{
+		{
+			name: "successful https connection",
+			res: CurlJSONProbeResult{
+				HTTPCode:       200,
+				RemoteIP:       "34.223.124.45",
+				RemotePort:     443,
+				Scheme:         "HTTPS",
+				TimeConnect:    0.1,
+				TimeAppConnect: 0.2,
+				TimeTotal:      0.3,
+				URL:            "https://quay.io:443",
+			},
+			want: output.EndpointResult{
+				URL:      "https://quay.io:443",
+				Host:     "quay.io",
+				Port:     443,
+				Scheme:   "https",
+				RemoteIP: "34.223.124.45",
+				HTTPCode: 200,
+				Timings:  output.EndpointTimings{Connect: 0.1, AppConnect: 0.2, Total: 0.3},
+				Status:   output.EndpointPassed,
+			},
+		},
+		{
+			name: "failed telnet connection",
+			res: CurlJSONProbeResult{
+				ErrorMsg: "Connection timed out after 3000 milliseconds",
+				ExitCode: 28,
+				URL:      "telnet://inputs1.osdsecuritylogs.splunkcloud.com:9997",
+			},
+			want: output.EndpointResult{
+				URL:          "tcp://inputs1.osdsecuritylogs.splunkcloud.com:9997",
+				Host:         "inputs1.osdsecuritylogs.splunkcloud.com",
+				Port:         9997,
+				Scheme:       "tcp",
+				CurlExitCode: 28,
+				Status:       output.EndpointFailed,
+				Category:     output.FailureCategoryTimeout,
+				Message:      "Connection timed out after 3000 milliseconds",
+			},
+		},
+		{
+			name: "retried connection",
+			res: CurlJSONProbeResult{
+				HTTPCode:    200,
+				NumRetries:  &retries,
+				Scheme:      "HTTPS",
+				URL:         "https://quay.io:443",
+				CurlVersion: "libcurl/8.9.1 OpenSSL/3.2.2 zlib/1.3.1",
+			},
+			want: output.EndpointResult{
+				URL:      "https://quay.io:443",
+				Host:     "quay.io",
+				Port:     443,
+				Scheme:   "https",
+				HTTPCode: 200,
+				Attempts: 3,
+				Status:   output.EndpointPassed,
+			},
+		},
+		{
+			name: "retries from curl before v8.9.0",
+			res: CurlJSONProbeResult{
+				HTTPCode:    200,
+				NumRetries:  &retries,
+				Scheme:      "HTTPS",
+				URL:         "https://quay.io:443",
+				CurlVersion: "libcurl/7.76.1 OpenSSL/3.0.7 zlib/1.2.11",
+			},
+			want: output.EndpointResult{
+				URL:      "https://quay.io:443",
+				Host:     "quay.io",
+				Port:     443,
+				Scheme:   "https",
+				HTTPCode: 200,
+				Status:   output.EndpointPassed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.EndpointResult(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EndpointResult() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
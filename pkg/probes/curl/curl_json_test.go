@@ -21,6 +21,20 @@ func TestCurlJSONProbe_ImplementsProbeInterface(t *testing.T) {
 	var _ probes.Probe = (*Probe)(nil)
 }
 
+// TestCurlJSONProbe_Registered confirms that the probe registers itself under its aliases
+func TestCurlJSONProbe_Registered(t *testing.T) {
+	registration, err := probes.Lookup("Curl.Probe")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registration.Name != "curl" {
+		t.Errorf("got registration %q, want %q", registration.Name, "curl")
+	}
+	if _, ok := registration.New().(Probe); !ok {
+		t.Errorf("got probe of type %T, want %T", registration.New(), Probe{})
+	}
+}
+
 // TestCurlJSONProbe_GetExpandedUserData tests the correctness of the user-
 // data produced by the probe. This test is different from most other unit
 // tests in that it uses regexes to validate the output string (so that we
@@ -101,6 +115,34 @@ func TestCurlJSONProbe_GetExpandedUserData(t *testing.T) {
 			},
 			wantRegex: `#cloud-config[\s\S]* -k [\s\S]*http:\/\/example.com:80 https:\/\/example.org:443 https:\/\/example.net:443`,
 		},
+		{
+			name: "method-prefixed URLs provided",
+			userDataVariables: map[string]string{
+				"TIMEOUT":          "1",
+				"DELAY":            "2",
+				"URLS":             "https://example.org:443 GET=https://example.com:443/healthz",
+				"TLSDISABLED_URLS": "POST=https://example.net:8443/api",
+			},
+			wantRegex: `#cloud-config[\s\S]* -I [\s\S]* https:\/\/example.org:443 --proto[\s\S]*--next -X GET [\s\S]* https:\/\/example.com:443\/healthz --proto =http,https --next -k -X POST [\s\S]* https:\/\/example.net:8443\/api --proto`,
+		},
+		{
+			name: "URLs with timeout and retry overrides provided",
+			userDataVariables: map[string]string{
+				"TIMEOUT": "2s",
+				"DELAY":   "2",
+				"URLS":    "https://example.org:443 timeout:30=https://slow.example.com:443 retries:0=https://a.example.com:443 timeout:2=https://b.example.com:443 GET,timeout:30,retries:5=https://example.com:443/healthz retries:0=telnet://c.example.com:9997",
+			},
+			wantRegex: `-m 2.00 [\s\S]* https:\/\/example.org:443 https:\/\/b.example.com:443 --proto[\s\S]*--next -t B -s -I --retry 3 --retry-connrefused -m 30.00 [\s\S]* https:\/\/slow.example.com:443 --proto =http,https,telnet --next -t B -s -I --retry 0 --retry-connrefused -m 2.00 [\s\S]* https:\/\/a.example.com:443 telnet:\/\/c.example.com:9997 --proto =http,https,telnet --next -X GET -s -o \/dev\/null --retry 5 --retry-connrefused -m 30.00 [\s\S]* https:\/\/example.com:443\/healthz --proto =http,https`,
+		},
+		{
+			name: "URL timeout below curl's precision provided",
+			userDataVariables: map[string]string{
+				"TIMEOUT": "2s",
+				"DELAY":   "2",
+				"URLS":    "https://example.org:443 timeout:0.004=https://fast.example.com:443",
+			},
+			wantRegex: `--next -t B -s -I --retry 3 --retry-connrefused -m 0.01 [\s\S]* https:\/\/fast.example.com:443 --proto`,
+		},
 		{
 			name:                      "missing variables required by directive",
 			userDataVariables:         map[string]string{},
@@ -12,6 +12,7 @@ import (
 	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
 	"github.com/openshift/osd-network-verifier/pkg/helpers"
 	"github.com/openshift/osd-network-verifier/pkg/output"
+	"github.com/openshift/osd-network-verifier/pkg/probes"
 )
 
 // legacy.Probe is an implementation of the probes.Probe interface that aims to mimic the functionality
@@ -41,6 +42,21 @@ var presetUserDataVariables = map[string]string{
 	"IMAGE": "$IMAGE",
 }
 
+func init() {
+	probes.Register(probes.Registration{
+		Name:    "legacy",
+		Aliases: []string{"legacyprobe", "legacy.probe"},
+		New:     func() probes.Probe { return Probe{} },
+		Capabilities: probes.Capabilities{
+			Platforms:     []cloud.Platform{cloud.AWSClassic, cloud.AWSHCP, cloud.AWSHCPZeroEgress},
+			Architectures: []cpu.Architecture{cpu.ArchX86},
+			// The validator binary's URL list is baked into its image
+			CustomEgressLists: false,
+			Proxies:           true,
+		},
+	})
+}
+
 // GetStartingToken returns the string token used to signal the beginning of the probe's output
 func (lgp Probe) GetStartingToken() string { return startingToken }
 
@@ -104,7 +120,7 @@ func (lgp Probe) GetExpandedUserData(userDataVariables map[string]string) (strin
 // ParseProbeOutput accepts a string containing all probe output that appeared between
 // the startingToken and the endingToken and a pointer to an Output object. outputDestination
 // will be filled with the results from the egress check
-func (lgp Probe) ParseProbeOutput(ensurePrivate bool, probeOutput string, outputDestination *output.Output) {
+func (lgp Probe) ParseProbeOutput(probeOutput string, outputDestination *output.Output) {
 	// reSuccess indicates that network validation was successful
 	reSuccess := regexp.MustCompile(`Success!`)
 
@@ -166,6 +182,11 @@ func isEgressFailurePresent(probeOutput string, outputDestination *output.Output
 	for _, e := range egressFailures {
 		if len(e) == 2 {
 			outputDestination.SetEgressFailures([]string{e[1]})
+			outputDestination.AddCheck(output.Check{
+				Suite:   "egress",
+				Name:    e[1],
+				Message: e[0],
+			})
 			found = true
 		}
 	}
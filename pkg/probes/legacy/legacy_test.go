@@ -21,6 +21,20 @@ func TestLegacyProbe_ImplementsProbeInterface(t *testing.T) {
 	var _ probes.Probe = (*Probe)(nil)
 }
 
+// TestLegacyProbe_Registered confirms that the probe registers itself under its aliases
+func TestLegacyProbe_Registered(t *testing.T) {
+	registration, err := probes.Lookup("LegacyProbe")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registration.Name != "legacy" {
+		t.Errorf("got registration %q, want %q", registration.Name, "legacy")
+	}
+	if _, ok := registration.New().(Probe); !ok {
+		t.Errorf("got probe of type %T, want %T", registration.New(), Probe{})
+	}
+}
+
 // TestLegacyProbe_GetMachineImageID tests this probe's cloud VM image lookup table
 func TestLegacyProbe_GetMachineImageID(t *testing.T) {
 	type args struct {
@@ -6,10 +6,42 @@ import (
 	"github.com/openshift/osd-network-verifier/pkg/output"
 )
 
+// Probe is implemented by every probe the verifier can launch. ParseProbeOutput used to take an
+// ensurePrivate flag as its first parameter; whether endpoints were reached at the expected (e.g.,
+// private) addresses is now checked by the verifier against the egress list, so probes only record
+// what they observed. Probes implementing the former signature can be adapted with
+// FromEnsurePrivateProbe
 type Probe interface {
+	GetMachineImageID(platformType cloud.Platform, cpuArch cpu.Architecture, region string) (string, error)
+	GetStartingToken() string
+	GetEndingToken() string
+	GetExpandedUserData(map[string]string) (string, error)
+	ParseProbeOutput(string, *output.Output)
+}
+
+// EnsurePrivateProbe is the former Probe interface, whose ParseProbeOutput took an ensurePrivate
+// flag.
+//
+// Deprecated: implement Probe instead
+type EnsurePrivateProbe interface {
 	GetMachineImageID(platformType cloud.Platform, cpuArch cpu.Architecture, region string) (string, error)
 	GetStartingToken() string
 	GetEndingToken() string
 	GetExpandedUserData(map[string]string) (string, error)
 	ParseProbeOutput(bool, string, *output.Output)
 }
+
+// FromEnsurePrivateProbe adapts a probe implementing the former Probe interface. Its
+// ParseProbeOutput is always passed false for ensurePrivate, as the verifier now checks addresses
+// itself
+func FromEnsurePrivateProbe(probe EnsurePrivateProbe) Probe {
+	return ensurePrivateProbeAdapter{probe}
+}
+
+type ensurePrivateProbeAdapter struct {
+	EnsurePrivateProbe
+}
+
+func (a ensurePrivateProbeAdapter) ParseProbeOutput(probeOutput string, outputDestination *output.Output) {
+	a.EnsurePrivateProbe.ParseProbeOutput(false, probeOutput, outputDestination)
+}
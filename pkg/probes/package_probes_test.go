@@ -0,0 +1,40 @@
+package probes
+
+import (
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+)
+
+// fakeEnsurePrivateProbe records the arguments its ParseProbeOutput was called with
+type fakeEnsurePrivateProbe struct {
+	ensurePrivate *bool
+	probeOutput   *string
+}
+
+func (fakeEnsurePrivateProbe) GetMachineImageID(cloud.Platform, cpu.Architecture, string) (string, error) {
+	return "ami-fake", nil
+}
+func (fakeEnsurePrivateProbe) GetStartingToken() string { return "FAKE_START" }
+func (fakeEnsurePrivateProbe) GetEndingToken() string   { return "FAKE_END" }
+func (fakeEnsurePrivateProbe) GetExpandedUserData(map[string]string) (string, error) {
+	return "", nil
+}
+func (p fakeEnsurePrivateProbe) ParseProbeOutput(ensurePrivate bool, probeOutput string, _ *output.Output) {
+	*p.ensurePrivate, *p.probeOutput = ensurePrivate, probeOutput
+}
+
+func TestFromEnsurePrivateProbe(t *testing.T) {
+	ensurePrivate, probeOutput := true, ""
+	probe := FromEnsurePrivateProbe(fakeEnsurePrivateProbe{ensurePrivate: &ensurePrivate, probeOutput: &probeOutput})
+
+	probe.ParseProbeOutput("raw output", &output.Output{})
+	if ensurePrivate || probeOutput != "raw output" {
+		t.Errorf("ParseProbeOutput() was called with %v, %q, want false, %q", ensurePrivate, probeOutput, "raw output")
+	}
+	if token := probe.GetStartingToken(); token != "FAKE_START" {
+		t.Errorf("GetStartingToken() = %q, want the adapted probe's token", token)
+	}
+}
@@ -0,0 +1,132 @@
+package probes
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
+)
+
+// Capabilities declares what a registered probe supports, so that callers can reject options the
+// probe would otherwise silently ignore
+type Capabilities struct {
+	// Platforms the probe can run on
+	Platforms []cloud.Platform
+	// Architectures the probe's machine images are available for
+	Architectures []cpu.Architecture
+	// CustomEgressLists is true if the probe tests the egress list it's given (including custom
+	// lists and overlays) rather than a list of its own
+	CustomEgressLists bool
+	// Proxies is true if the probe sends its requests through the configured proxy
+	Proxies bool
+}
+
+// Requirements are the options a verifier run needs its probe to support. Zero values require nothing
+type Requirements struct {
+	Platform         cloud.Platform
+	Architecture     cpu.Architecture
+	CustomEgressList bool
+	Proxy            bool
+}
+
+// Registration describes a probe selectable by name, e.g., with the egress subcommand's --probe flag
+type Registration struct {
+	// Name is the probe's canonical name. Names and aliases are matched case-insensitively
+	Name    string
+	Aliases []string
+	// New returns a new instance of the probe
+	New          func() Probe
+	Capabilities Capabilities
+}
+
+// Check returns an error listing every requirement the probe doesn't support, or nil if it
+// supports them all
+func (r Registration) Check(req Requirements) error {
+	var errs []error
+	if req.Platform.IsValid() && !slices.Contains(r.Capabilities.Platforms, req.Platform) {
+		errs = append(errs, fmt.Errorf("the %s probe doesn't support platform '%s'", r.Name, req.Platform))
+	}
+	if req.Architecture.IsValid() && !slices.Contains(r.Capabilities.Architectures, req.Architecture) {
+		errs = append(errs, fmt.Errorf("the %s probe doesn't support CPU architecture '%s'", r.Name, req.Architecture))
+	}
+	if req.CustomEgressList && !r.Capabilities.CustomEgressLists {
+		errs = append(errs, fmt.Errorf("the %s probe doesn't support custom egress lists or overlays", r.Name))
+	}
+	if req.Proxy && !r.Capabilities.Proxies {
+		errs = append(errs, fmt.Errorf("the %s probe doesn't support proxies", r.Name))
+	}
+	return errors.Join(errs...)
+}
+
+// registry holds probe registrations by lowercase name and alias
+type registry struct {
+	mu            sync.RWMutex
+	registrations map[string]Registration
+}
+
+var defaultRegistry = &registry{}
+
+// Register makes a probe selectable by its name and aliases. Probe packages call it from init(), so
+// importing a probe package (if only for its side effects) is enough to make it available. Register
+// panics if the registration has no name or constructor, or if a name or alias is already taken
+func Register(r Registration) {
+	defaultRegistry.register(r)
+}
+
+// Lookup returns the registration of the probe with the given name or alias
+func Lookup(name string) (Registration, error) {
+	return defaultRegistry.lookup(name)
+}
+
+// Names returns the sorted canonical names of every registered probe
+func Names() []string {
+	return defaultRegistry.names()
+}
+
+func (reg *registry) register(r Registration) {
+	if r.Name == "" || r.New == nil {
+		panic("probes: Register requires a name and a constructor")
+	}
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if reg.registrations == nil {
+		reg.registrations = map[string]Registration{}
+	}
+	keys := append([]string{r.Name}, r.Aliases...)
+	for _, key := range keys {
+		if existing, ok := reg.registrations[strings.ToLower(key)]; ok {
+			panic(fmt.Sprintf("probes: '%s' is already registered by the %s probe", key, existing.Name))
+		}
+	}
+	for _, key := range keys {
+		reg.registrations[strings.ToLower(key)] = r
+	}
+}
+
+func (reg *registry) lookup(name string) (Registration, error) {
+	reg.mu.RLock()
+	r, ok := reg.registrations[strings.ToLower(name)]
+	reg.mu.RUnlock()
+	if !ok {
+		return Registration{}, fmt.Errorf("'%s' does not match any known probes, must be one of %s", name, strings.Join(reg.names(), ", "))
+	}
+	return r, nil
+}
+
+func (reg *registry) names() []string {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	var names []string
+	for key, r := range reg.registrations {
+		if key == strings.ToLower(r.Name) {
+			names = append(names, r.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
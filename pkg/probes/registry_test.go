@@ -0,0 +1,95 @@
+package probes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
+	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
+)
+
+func TestRegistry(t *testing.T) {
+	reg := &registry{}
+	reg.register(Registration{Name: "Fake", Aliases: []string{"fake.probe"}, New: func() Probe { return nil }})
+	reg.register(Registration{Name: "another", New: func() Probe { return nil }})
+
+	for _, name := range []string{"Fake", "fake", "FAKE.PROBE"} {
+		if r, err := reg.lookup(name); err != nil || r.Name != "Fake" {
+			t.Errorf("lookup(%q) = %q, %v, want Fake", name, r.Name, err)
+		}
+	}
+	if _, err := reg.lookup("missing"); err == nil || !strings.Contains(err.Error(), "must be one of Fake, another") {
+		t.Errorf("expected an error listing the registered probes, got %v", err)
+	}
+	if got := strings.Join(reg.names(), ","); got != "Fake,another" {
+		t.Errorf("names() = %q, want %q", got, "Fake,another")
+	}
+
+	for _, r := range []Registration{
+		{Name: "FAKE", New: func() Probe { return nil }},
+		{Name: "other", Aliases: []string{"fake.probe"}, New: func() Probe { return nil }},
+		{Name: "unconstructable"},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected registering %+v to panic", r)
+				}
+			}()
+			reg.register(r)
+		}()
+	}
+	if _, err := reg.lookup("other"); err == nil {
+		t.Error("expected a failed registration to leave no names behind")
+	}
+}
+
+func TestRegistration_Check(t *testing.T) {
+	r := Registration{Name: "fake", Capabilities: Capabilities{
+		Platforms:     []cloud.Platform{cloud.AWSClassic},
+		Architectures: []cpu.Architecture{cpu.ArchX86},
+		Proxies:       true,
+	}}
+
+	tests := []struct {
+		name    string
+		req     Requirements
+		wantErr []string
+	}{
+		{
+			name: "no requirements",
+		},
+		{
+			name: "supported",
+			req:  Requirements{Platform: cloud.AWSClassic, Architecture: cpu.ArchX86, Proxy: true},
+		},
+		{
+			name:    "unsupported platform",
+			req:     Requirements{Platform: cloud.GCPClassic},
+			wantErr: []string{"the fake probe doesn't support platform 'gcp-classic'"},
+		},
+		{
+			name: "several unsupported options",
+			req:  Requirements{Platform: cloud.AWSClassic, Architecture: cpu.ArchARM, CustomEgressList: true},
+			wantErr: []string{
+				"the fake probe doesn't support CPU architecture 'arm'",
+				"the fake probe doesn't support custom egress lists or overlays",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := r.Check(tt.req)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != strings.Join(tt.wantErr, "\n") {
+				t.Errorf("got error %v, want %q", err, strings.Join(tt.wantErr, "\n"))
+			}
+		})
+	}
+}
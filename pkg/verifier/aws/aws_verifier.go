@@ -78,7 +78,13 @@ const (
 type AwsVerifier struct {
 	AwsClient *aws.Client
 	Logger    ocmlog.Logger
-	Output    output.Output
+	// Output is no longer populated, as each ValidateEgress and VerifyDns run now records its
+	// results in an output of its own so that concurrent runs don't mix them.
+	//
+	// Deprecated: use the output returned by ValidateEgress or VerifyDns instead
+	Output output.Output
+	// out collects the results of a single ValidateEgress or VerifyDns run; see forRun()
+	out *output.Output
 	// This cache is only to be used inside of describeInstanceType() to minimize nil ptr error risk
 	cachedInstanceTypeInfo *ec2Types.InstanceTypeInfo
 }
@@ -127,7 +133,6 @@ func NewAwsVerifier(accessID, accessSecret, sessionToken, region, profile string
 	return &AwsVerifier{
 		AwsClient: awsClient,
 		Logger:    logger,
-		Output:    output.Output{},
 	}, nil
 }
 
@@ -321,6 +326,7 @@ func (a *AwsVerifier) createEC2Instance(input createEC2InstanceInput) (string, e
 		instanceReq.KeyName = awsTools.String(DEBUG_KEY_NAME)
 	}
 	// Finally, we make our request
+	launchStart := time.Now()
 	instanceResp, err := a.AwsClient.RunInstances(input.ctx, &instanceReq)
 	if err != nil {
 		return "", handledErrors.NewGenericError(err)
@@ -345,11 +351,12 @@ func (a *AwsVerifier) createEC2Instance(input createEC2InstanceInput) (string, e
 		}
 		return "", fmt.Errorf("%s: terminated %s after timing out waiting for instance to be running", err, instanceID)
 	}
+	a.out.SetInstanceLaunchDuration(time.Since(launchStart))
 
 	return instanceID, nil
 }
 
-func (a *AwsVerifier) findUnreachableEndpoints(ctx context.Context, instanceID string, probe probes.Probe, ensurePrivate bool) error {
+func (a *AwsVerifier) findUnreachableEndpoints(ctx context.Context, instanceID string, probe probes.Probe) error {
 	var consoleOutput string
 
 	a.writeDebugLogs(ctx, "Scraping console output and waiting for user data script to complete...")
@@ -389,7 +396,7 @@ func (a *AwsVerifier) findUnreachableEndpoints(ctx context.Context, instanceID s
 		if !startingTokenSeen {
 			if endingTokenSeen {
 				a.writeDebugLogs(ctx, fmt.Sprintf("raw console logs:\n---\n%s\n---", consoleOutput))
-				return false, handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: endingToken encountered before startingToken"))
+				return false, handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: endingToken encountered before startingToken")).WithCode(handledErrors.CodeProbeCorrupt)
 			}
 			a.writeDebugLogs(ctx, "consoleOutput contains data, but probe has not yet printed startingToken, continuing to wait...")
 			return false, nil
@@ -405,14 +412,17 @@ func (a *AwsVerifier) findUnreachableEndpoints(ctx context.Context, instanceID s
 		rawProbeOutput := strings.TrimSpace(helpers.CutBetween(consoleOutput, probe.GetStartingToken(), probe.GetEndingToken()))
 		if len(rawProbeOutput) < 1 {
 			a.writeDebugLogs(ctx, fmt.Sprintf("raw console logs:\n---\n%s\n---", consoleOutput))
-			return false, handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: no data between startingToken and endingToken"))
+			return false, handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: no data between startingToken and endingToken")).WithCode(handledErrors.CodeProbeCorrupt)
 		}
 
 		// Send probe's output off to the Probe interface for parsing
 		a.writeDebugLogs(ctx, fmt.Sprintf("probe output:\n---\n%s\n---", rawProbeOutput))
-		probe.ParseProbeOutput(ensurePrivate, rawProbeOutput, &a.Output)
+		probe.ParseProbeOutput(rawProbeOutput, a.out)
 		return true, nil
 	})
+	if errors.Is(err, helpers.ErrWaitTimeout) {
+		return handledErrors.NewGenericError(err).WithCode(handledErrors.CodeProbeTimeout)
+	}
 
 	return err
 }
@@ -430,8 +440,21 @@ func buildTags(tags map[string]string) []ec2Types.Tag {
 	return tagList
 }
 
+// forRun returns a copy of the verifier that records into a new output, so that runs sharing a
+// verifier don't mix their results
+func (a *AwsVerifier) forRun() *AwsVerifier {
+	return &AwsVerifier{
+		AwsClient:              a.AwsClient,
+		Logger:                 a.Logger,
+		out:                    &output.Output{},
+		cachedInstanceTypeInfo: a.cachedInstanceTypeInfo,
+	}
+}
+
 func (a *AwsVerifier) writeDebugLogs(ctx context.Context, log string) {
-	a.Output.AddDebugLogs(log)
+	if a.out != nil {
+		a.out.AddDebugLogs(log)
+	}
 	a.Logger.Debug(ctx, log)
 }
 
@@ -3,6 +3,7 @@ package awsverifier
 import (
 	"encoding/base64"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"time"
@@ -31,8 +32,12 @@ const (
 // - prepare for ec2 instance creation
 // - create instance and wait till it gets ready, wait for userdata script execution
 // - find unreachable endpoints & parse output, then terminate instance
-// - return `a.output` which stores the execution results
+// - return a new output which stores the execution results
 func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.Output {
+	a = a.forRun()
+	start := time.Now()
+	defer func() { a.out.SetRunDuration(time.Since(start)) }()
+
 	// Validate cloud platform type
 	if !vei.PlatformType.IsValid() {
 		vei.PlatformType = cloud.AWSClassic
@@ -53,18 +58,29 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 	// Determine instance type and CPUArchitecture
 	vei.InstanceType, vei.CPUArchitecture, err = a.selectInstanceType(vei.Ctx, vei.InstanceType, vei.CPUArchitecture)
 	if err != nil {
-		return a.Output.AddError(err)
+		return a.out.AddError(err)
 	}
 
 	// If no AMI specificed, select one based on CPU arch and region
 	if vei.CloudImageID == "" {
 		vei.CloudImageID, err = vei.Probe.GetMachineImageID(vei.PlatformType, vei.CPUArchitecture, a.AwsClient.Region)
 		if err != nil {
-			return a.Output.AddError(fmt.Errorf("failed to determine default machine image: %w", err))
+			return a.out.AddError(handledErrors.NewGenericError(fmt.Errorf("failed to determine default machine image: %w", err)).WithCode(handledErrors.CodeInvalidConfiguration))
 		}
 		a.writeDebugLogs(vei.Ctx, fmt.Sprintf("defaulted to machine image %s", vei.CloudImageID))
 	}
 
+	// Record the run's parameters so that they're available to consumers of the output
+	metadata := output.RunMetadata{
+		Platform:     vei.PlatformType.String(),
+		Region:       a.AwsClient.Region,
+		SubnetID:     vei.SubnetID,
+		Probe:        fmt.Sprintf("%T", vei.Probe),
+		CloudImageID: vei.CloudImageID,
+		InstanceType: vei.InstanceType,
+	}
+	a.out.SetMetadata(metadata)
+
 	// Select legacy probe config file based on platform type (ignored unless legacy.Probe in use)
 	configPath := fmt.Sprintf(CONFIG_PATH_FSTRING, vei.PlatformType)
 
@@ -75,7 +91,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 		PubKey, err := os.ReadFile(vei.ImportKeyPair)
 		debugPubKey = PubKey
 		if err != nil {
-			return a.Output.AddError(err)
+			return a.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
 		}
 
 		//Import Keypair into aws keypairs to be attached later to the created debug instance
@@ -84,7 +100,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 			PublicKeyMaterial: debugPubKey,
 		})
 		if err != nil {
-			return a.Output.AddError(err)
+			return a.out.AddError(err)
 		}
 
 		//If we have imported a pubkey for debug we would like debug intance to stay up.
@@ -98,7 +114,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 
 		//Terminate the debug instance
 		if err := a.AwsClient.TerminateEC2Instance(vei.Ctx, vei.TerminateDebugInstance); err != nil {
-			a.Output.AddError(err)
+			a.out.AddError(err)
 		}
 
 		//Check if a keypair was uploaded
@@ -116,12 +132,12 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 			})
 			//if there was any issues deleting the keypair.
 			if err != nil {
-				a.Output.AddError(err)
+				a.out.AddError(err)
 			}
 
 		}
 
-		return &a.Output
+		return a.out
 	}
 
 	// Fetch the egress URL list from github, falling back to local lists in the event of a failure.
@@ -129,29 +145,50 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 	// as that probe only knows how to use the egress URL lists baked into its
 	// AMIs/container images
 	egressListYaml := vei.EgressListYaml
-	var egressListStr, tlsDisabledEgressListStr string
+	egressListVariables := map[string]string{egress_lists.VariableAWSRegion: a.AwsClient.Region}
+	maps.Copy(egressListVariables, vei.EgressListVariables)
+	var egressList *egress_lists.EgressList
+	metadata.EgressListSource = "custom"
 	if egressListYaml == "" {
-		githubEgressList, githubListErr := egress_lists.GetGithubEgressList(vei.PlatformType)
-		if githubListErr == nil {
-			egressListYaml, githubListErr = githubEgressList.GetContent()
-			if githubListErr == nil {
-				a.Logger.Info(vei.Ctx, "Using egress URL list from %s at SHA %s", githubEgressList.GetURL(), githubEgressList.GetSHA())
-				egressListStr, tlsDisabledEgressListStr, githubListErr = egress_lists.EgressListToString(egressListYaml, map[string]string{"AWS_REGION": a.AwsClient.Region})
-			}
+		fetched, err := egress_lists.FetchEgressList(vei.Ctx, vei.PlatformType, vei.EgressListFetchOptions)
+		if err != nil {
+			return a.out.AddError(handledErrors.NewGenericError(err))
 		}
-
-		if githubListErr != nil {
-			a.Logger.Error(vei.Ctx, "Failed to get egress list from GitHub, falling back to local list: %v", githubListErr)
-			egressListYaml, err = egress_lists.GetLocalEgressList(vei.PlatformType)
-			if err != nil {
-				return a.Output.AddError(err)
-			}
-			egressListStr, tlsDisabledEgressListStr, err = egress_lists.EgressListToString(egressListYaml, map[string]string{"AWS_REGION": a.AwsClient.Region})
-			if err != nil {
-				return a.Output.AddError(err)
-			}
+		egressList, err = fetched.Parse(vei.PlatformType, egressListVariables)
+		if err != nil {
+			return a.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
+		}
+		verifier.SetEgressListMetadata(&metadata, fetched)
+		if fetched.FallbackReason != "" {
+			a.Logger.Error(vei.Ctx, "%s", fetched.FallbackReason)
+		} else {
+			a.Logger.Info(vei.Ctx, "Using %s", fetched.Description())
+		}
+		if fetched.Unverified {
+			a.Logger.Warn(vei.Ctx, "The signature of the %s wasn't verified", fetched.Description())
 		}
+	} else {
+		egressList, err = egress_lists.ParseEgressList(egressListYaml, egressListVariables)
+		if err != nil {
+			return a.out.AddError(handledErrors.NewGenericError(fmt.Errorf("invalid custom egress list: %w", err)).WithCode(handledErrors.CodeInvalidConfiguration))
+		}
+	}
+	if len(vei.EgressListOverlays) > 0 {
+		egressList, err = egressList.ApplyOverlays(vei.EgressListOverlays, egressListVariables)
+		if err != nil {
+			return a.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
+		}
+	}
+	// Skip endpoints that don't apply to this region (e.g., commercial-only endpoints in GovCloud)
+	egressList = egressList.ForRegion(a.AwsClient.Region)
+	if vei.PlatformType == cloud.AWSHCPZeroEgress {
+		// Zero-egress clusters must reach every endpoint privately (e.g., through VPC endpoints),
+		// unless the egress list says otherwise
+		egressList = egressList.WithDefaultExpectedAddresses(egress_lists.AddressPrivate)
 	}
+	egressListStr, tlsDisabledEgressListStr := egressList.ToString()
+
+	a.out.SetMetadata(metadata)
 
 	// Generate the userData file
 	// As expand replaces all ${var} (using empty string for unknown ones), adding the env variables used in userdata.yaml
@@ -177,14 +214,14 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 
 	unencodedUserData, err := vei.Probe.GetExpandedUserData(userDataVariables)
 	if err != nil {
-		return a.Output.AddError(err)
+		return a.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
 	}
 	unencodedUserDataBytes := []byte(unencodedUserData)
 	// Enforce AWS-imposed userdata limit
 	if len(unencodedUserDataBytes) > 16384 { // 16KB
-		return a.Output.AddError(
+		return a.out.AddError(handledErrors.NewGenericError(
 			fmt.Errorf("userdata size exceeds AWS-imposed 16KB limit; if using a CA certificate, please check its file size"),
-		)
+		).WithCode(handledErrors.CodeInvalidConfiguration))
 	}
 	userData := base64.StdEncoding.EncodeToString([]byte(unencodedUserData))
 
@@ -192,7 +229,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 
 	vpcId, err := a.GetVpcIdFromSubnetId(vei.Ctx, vei.SubnetID)
 	if err != nil {
-		return a.Output.AddError(err)
+		return a.out.AddError(err)
 	}
 
 	// If security group not given, create a temporary one
@@ -200,7 +237,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 
 		createSecurityGroupOutput, err := a.CreateSecurityGroup(vei.Ctx, vei.Tags, "osd-network-verifier", vpcId)
 		if err != nil {
-			return a.Output.AddError(err)
+			return a.out.AddError(err)
 		}
 		vei.AWS.TempSecurityGroup = *createSecurityGroupOutput.GroupId
 
@@ -222,7 +259,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 			// Add the new rules to the temp security group
 			_, err := a.AllowSecurityGroupProxyEgress(vei.Ctx, vei.AWS.TempSecurityGroup, proxyUrls)
 			if err != nil {
-				return a.Output.AddError(err)
+				return a.out.AddError(err)
 			}
 		}
 
@@ -243,23 +280,19 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 		keyPair:             vei.ImportKeyPair,
 	})
 	if err != nil {
-		return a.Output.AddError(err)
+		return a.out.AddError(err)
 	}
 
-	// ensurePrivate is a flag to ensure the return IP address from the given hosts are private defined in RFC1918
-	// Currently, it will be used the Zero Egress cluster check only
-	ensurePrivate := false
-	if vei.PlatformType == cloud.AWSHCPZeroEgress {
-		ensurePrivate = true
-	}
-	// findUnreachableEndpoints will call Probe.ParseProbeOutput(), which will store egress failures in a.Output.failures
-	// when ensurePrivate is true, it will also check if the returned IP is private
-	err = a.findUnreachableEndpoints(vei.Ctx, instanceID, vei.Probe, ensurePrivate)
+	// findUnreachableEndpoints will call Probe.ParseProbeOutput(), which will store egress failures in a.out.failures
+	err = a.findUnreachableEndpoints(vei.Ctx, instanceID, vei.Probe)
 
 	if err != nil {
-		a.Output.AddError(err)
+		a.out.AddError(err)
 		// Don't return yet; still need to terminate instance
 	}
+	// Mark optional endpoints, check expected statuses and addresses, and attach the egress list's
+	// documentation to each endpoint result
+	verifier.AnnotateEndpoints(a.out, egressList)
 
 	// Terminate the EC2 instance (unless user requests otherwise)
 	if !vei.SkipInstanceTermination {
@@ -281,7 +314,7 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 			},
 		})
 		if err != nil {
-			a.Output.AddError(err)
+			a.out.AddError(err)
 			a.Logger.Info(vei.Ctx, "Unable to describe security groups. Falling back to slower cloud resource cleanup method.")
 
 		}
@@ -309,11 +342,11 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 
 		a.Logger.Info(vei.Ctx, "Deleting instance with ID: %s", instanceID)
 		if err := a.AwsClient.TerminateEC2Instance(vei.Ctx, instanceID); err != nil {
-			a.Output.AddError(err)
+			a.out.AddError(err)
 		}
 	}
 
-	return &a.Output
+	return a.out
 }
 
 // VerifyDns performs verification process for VPC's DNS
@@ -321,18 +354,28 @@ func (a *AwsVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 // - ask AWS API for VPC attributes
 // - ensure they're set correctly
 func (a *AwsVerifier) VerifyDns(vdi verifier.VerifyDnsInput) *output.Output {
+	a = a.forRun()
+	start := time.Now()
+	defer func() { a.out.SetRunDuration(time.Since(start)) }()
+
 	a.Logger.Info(vdi.Ctx, "Verifying DNS config for VPC %s", vdi.VpcID)
+	a.out.SetMetadata(output.RunMetadata{
+		Region: a.AwsClient.Region,
+		VpcID:  vdi.VpcID,
+	})
 	// Request boolean values from AWS API
 	dnsSprtResult, err := a.AwsClient.DescribeVpcAttribute(vdi.Ctx, &ec2.DescribeVpcAttributeInput{
 		Attribute: ec2Types.VpcAttributeNameEnableDnsSupport,
 		VpcId:     awsTools.String(vdi.VpcID),
 	})
 	if err != nil {
-		a.Output.AddError(handledErrors.NewGenericError(err))
-		a.Output.AddException(handledErrors.NewGenericError(
-			fmt.Errorf("failed to validate the %s attribute on VPC: %s is true", ec2Types.VpcAttributeNameEnableDnsSupport, vdi.VpcID)),
+		apiErr := handledErrors.NewGenericError(err)
+		a.out.AddError(apiErr)
+		a.out.AddException(handledErrors.NewGenericError(
+			fmt.Errorf("failed to validate the %s attribute on VPC: %s is true", ec2Types.VpcAttributeNameEnableDnsSupport, vdi.VpcID)).WithCode(apiErr.Code()),
 		)
-		return &a.Output
+		a.out.AddCheck(output.Check{Suite: "dns", Name: string(ec2Types.VpcAttributeNameEnableDnsSupport), Message: err.Error()})
+		return a.out
 	}
 
 	dnsHostResult, err := a.AwsClient.DescribeVpcAttribute(vdi.Ctx, &ec2.DescribeVpcAttributeInput{
@@ -340,38 +383,53 @@ func (a *AwsVerifier) VerifyDns(vdi verifier.VerifyDnsInput) *output.Output {
 		VpcId:     awsTools.String(vdi.VpcID),
 	})
 	if err != nil {
-		a.Output.AddError(handledErrors.NewGenericError(err))
-		a.Output.AddException(handledErrors.NewGenericError(
+		apiErr := handledErrors.NewGenericError(err)
+		a.out.AddError(apiErr)
+		a.out.AddException(handledErrors.NewGenericError(
 			fmt.Errorf("failed to validate the %s attribute on VPC: %s is true", ec2Types.VpcAttributeNameEnableDnsHostnames, vdi.VpcID),
-		))
-		return &a.Output
+		).WithCode(apiErr.Code()))
+		a.out.AddCheck(output.Check{Suite: "dns", Name: string(ec2Types.VpcAttributeNameEnableDnsHostnames), Message: err.Error()})
+		return a.out
 	}
 	// Verify results
 	a.Logger.Info(vdi.Ctx, "DNS Support for VPC %s: %t", vdi.VpcID, *dnsSprtResult.EnableDnsSupport.Value)
 	a.Logger.Info(vdi.Ctx, "DNS Hostnames for VPC %s: %t", vdi.VpcID, *dnsHostResult.EnableDnsHostnames.Value)
+	dnsSprtCheck := output.Check{Suite: "dns", Name: string(ec2Types.VpcAttributeNameEnableDnsSupport), Passed: true}
 	if !(*dnsSprtResult.EnableDnsSupport.Value) {
-		a.Output.AddException(handledErrors.NewGenericError(
+		err := handledErrors.NewGenericError(
 			fmt.Errorf("the %s attribute on VPC: %s is %t, must be true", ec2Types.VpcAttributeNameEnableDnsSupport, vdi.VpcID, *dnsSprtResult.EnableDnsSupport.Value),
-		))
+		).WithCode(handledErrors.CodeDNSAttributeDisabled)
+		a.out.AddException(err)
+		dnsSprtCheck.Passed = false
+		dnsSprtCheck.Message = err.Error()
 	}
+	a.out.AddCheck(dnsSprtCheck)
 
+	dnsHostCheck := output.Check{Suite: "dns", Name: string(ec2Types.VpcAttributeNameEnableDnsHostnames), Passed: true}
 	if !(*dnsHostResult.EnableDnsHostnames.Value) {
-		a.Output.AddException(handledErrors.NewGenericError(
+		err := handledErrors.NewGenericError(
 			fmt.Errorf("the %s attribute on VPC: %s is %t, must be true", ec2Types.VpcAttributeNameEnableDnsHostnames, vdi.VpcID, *dnsHostResult.EnableDnsHostnames.Value),
-		))
+		).WithCode(handledErrors.CodeDNSAttributeDisabled)
+		a.out.AddException(err)
+		dnsHostCheck.Passed = false
+		dnsHostCheck.Message = err.Error()
 	}
+	a.out.AddCheck(dnsHostCheck)
 
-	return &a.Output
+	return a.out
 }
 
 // Cleans up the security groups created by network-verifier
 func CleanupSecurityGroup(vei verifier.ValidateEgressInput, a *AwsVerifier) *output.Output {
+	if a.out == nil {
+		a = a.forRun()
+	}
 	a.Logger.Info(vei.Ctx, "Deleting security group with ID: %s", vei.AWS.TempSecurityGroup)
 	_, err := a.AwsClient.DeleteSecurityGroup(vei.Ctx, &ec2.DeleteSecurityGroupInput{GroupId: awsTools.String(vei.AWS.TempSecurityGroup)})
 	if err != nil {
-		a.Output.AddError(handledErrors.NewGenericError(err))
-		a.Output.AddException(handledErrors.NewGenericError(fmt.Errorf("unable to cleanup security group %s, please manually clean up", vei.AWS.TempSecurityGroup)))
+		a.out.AddError(handledErrors.NewGenericError(err))
+		a.out.AddException(handledErrors.NewGenericError(fmt.Errorf("unable to cleanup security group %s, please manually clean up", vei.AWS.TempSecurityGroup)))
 
 	}
-	return &a.Output
+	return a.out
 }
@@ -0,0 +1,74 @@
+package verifier
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+)
+
+// SetEgressListMetadata records where the egress list used for a run came from in md
+func SetEgressListMetadata(md *output.RunMetadata, fetched *egress_lists.FetchResult) {
+	md.EgressListSource = fetched.Source
+	md.EgressListSHA = fetched.SHA
+	md.EgressListRef = fetched.Ref
+	md.EgressListCached = fetched.Cached
+	md.EgressListUnverified = fetched.Unverified
+	md.EgressListFallbackReason = fetched.FallbackReason
+}
+
+// AnnotateEndpoints attaches egressList's documentation of each endpoint recorded in out to the
+// endpoint's result, marks it as optional if the list doesn't require it, and records its wildcard
+// and anyOf group. It also applies the endpoint's protocol, expected status codes and expected
+// addresses: tls endpoints pass once the TLS handshake completed, whatever happened next, http(s)
+// endpoints fail if they responded with an unexpected status, and endpoints reached at an
+// unexpected address fail
+func AnnotateEndpoints(out *output.Output, egressList *egress_lists.EgressList) {
+	out.AnnotateEndpoints(func(result *output.EndpointResult) {
+		annotateEndpoint(result, egressList)
+	})
+}
+
+func annotateEndpoint(result *output.EndpointResult, egressList *egress_lists.EgressList) {
+	endpoint, ok := egressList.Annotate(result.URL, result.Host, result.Port)
+	if !ok {
+		return
+	}
+	result.Optional = !endpoint.Required
+	result.Wildcard = endpoint.Wildcard
+	result.AnyOf = endpoint.AnyOf
+
+	switch {
+	case endpoint.Protocol == egress_lists.ProtocolTLS:
+		result.Scheme = egress_lists.ProtocolTLS
+		result.URL = fmt.Sprintf("%s://%s", egress_lists.ProtocolTLS, result.HostPort())
+		if !result.Passed() && result.Timings.AppConnect > 0 {
+			result.Status = output.EndpointPassed
+			result.Category = output.FailureCategoryNone
+			result.Message = ""
+		}
+	case result.Passed() && len(endpoint.ExpectedStatus) > 0 && !slices.Contains(endpoint.ExpectedStatus, result.HTTPCode):
+		result.Status = output.EndpointFailed
+		result.Category = output.FailureCategoryHTTPStatus
+		result.Message = fmt.Sprintf("unexpected HTTP status %d, expected one of %v", result.HTTPCode, endpoint.ExpectedStatus)
+	}
+	if result.Passed() && !endpoint.ExpectsAddress(result.RemoteIP) {
+		result.Status = output.EndpointFailed
+		result.Category = output.FailureCategoryUnexpectedAddress
+		if slices.Equal(endpoint.ExpectedAddresses, []string{egress_lists.AddressPrivate}) {
+			// Kept apart as it's the long-standing zero-egress check
+			result.Category = output.FailureCategoryNonPrivateAddress
+		}
+		result.Message = fmt.Sprintf("reached at address '%s', expected one in %s", result.RemoteIP, strings.Join(endpoint.ExpectedAddresses, ", "))
+	}
+	if endpoint.Category != "" || endpoint.Owner != "" || endpoint.Description != "" || endpoint.Docs != "" {
+		result.Info = &output.EndpointInfo{
+			Category:    endpoint.Category,
+			Owner:       endpoint.Owner,
+			Description: endpoint.Description,
+			DocsURL:     endpoint.Docs,
+		}
+	}
+}
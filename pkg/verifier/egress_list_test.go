@@ -0,0 +1,163 @@
+package verifier
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	"github.com/openshift/osd-network-verifier/pkg/output"
+)
+
+func TestAnnotateEndpoints(t *testing.T) {
+	optional := false
+	egressList := &egress_lists.EgressList{Version: egress_lists.SchemaVersionV2, Endpoints: []egress_lists.Endpoint{
+		{Host: "quay.io", Ports: []int{443}},
+		{Host: "console.redhat.com", Ports: []int{80, 443}, Category: "telemetry", Required: &optional},
+		{Host: "api.openshift.com", Ports: []int{443}, Path: "/healthz", ExpectedStatus: []int{200, 204}},
+		{Host: "mirror.example.com", Ports: []int{8443}, Protocol: egress_lists.ProtocolTLS},
+		{Host: "*.apps.example.com", Ports: []int{443}, Samples: []string{"console", "oauth"}},
+		{Host: "mirror-a.example.com", Ports: []int{443}, AnyOf: "mirrors"},
+		{Host: "sts.us-east-1.amazonaws.com", Ports: []int{443}, ExpectedAddresses: []string{egress_lists.AddressPrivate}},
+		{Host: "s3.us-east-1.amazonaws.com", Ports: []int{443}, ExpectedAddresses: []string{egress_lists.AddressPublic, "10.0.0.0/16"}},
+	}}
+
+	tests := []struct {
+		name   string
+		result output.EndpointResult
+		want   output.EndpointResult
+	}{
+		{
+			name:   "required endpoint without documentation",
+			result: output.EndpointResult{Host: "quay.io", Port: 443},
+			want:   output.EndpointResult{Host: "quay.io", Port: 443},
+		},
+		{
+			name:   "optional endpoint",
+			result: output.EndpointResult{Host: "console.redhat.com", Port: 443},
+			want: output.EndpointResult{
+				Host:     "console.redhat.com",
+				Port:     443,
+				Optional: true,
+				Info:     &output.EndpointInfo{Category: "telemetry"},
+			},
+		},
+		{
+			name:   "expected status",
+			result: output.EndpointResult{URL: "https://api.openshift.com:443/healthz", Host: "api.openshift.com", Port: 443, HTTPCode: 204, Status: output.EndpointPassed},
+			want:   output.EndpointResult{URL: "https://api.openshift.com:443/healthz", Host: "api.openshift.com", Port: 443, HTTPCode: 204, Status: output.EndpointPassed},
+		},
+		{
+			name:   "unexpected status",
+			result: output.EndpointResult{URL: "https://api.openshift.com:443/healthz", Host: "api.openshift.com", Port: 443, HTTPCode: 403, Status: output.EndpointPassed},
+			want: output.EndpointResult{
+				URL:      "https://api.openshift.com:443/healthz",
+				Host:     "api.openshift.com",
+				Port:     443,
+				HTTPCode: 403,
+				Status:   output.EndpointFailed,
+				Category: output.FailureCategoryHTTPStatus,
+				Message:  "unexpected HTTP status 403, expected one of [200 204]",
+			},
+		},
+		{
+			name: "tls handshake completed",
+			result: output.EndpointResult{
+				URL:      "https://mirror.example.com:8443",
+				Host:     "mirror.example.com",
+				Port:     8443,
+				Scheme:   "https",
+				Timings:  output.EndpointTimings{AppConnect: 0.2},
+				Status:   output.EndpointFailed,
+				Category: output.FailureCategoryConnectionReset,
+				Message:  "Empty reply from server",
+			},
+			want: output.EndpointResult{
+				URL:     "tls://mirror.example.com:8443",
+				Host:    "mirror.example.com",
+				Port:    8443,
+				Scheme:  "tls",
+				Timings: output.EndpointTimings{AppConnect: 0.2},
+				Status:  output.EndpointPassed,
+			},
+		},
+		{
+			name: "tls handshake failed",
+			result: output.EndpointResult{
+				URL:      "https://mirror.example.com:8443",
+				Host:     "mirror.example.com",
+				Port:     8443,
+				Scheme:   "https",
+				Status:   output.EndpointFailed,
+				Category: output.FailureCategoryTLSHandshake,
+			},
+			want: output.EndpointResult{
+				URL:      "tls://mirror.example.com:8443",
+				Host:     "mirror.example.com",
+				Port:     8443,
+				Scheme:   "tls",
+				Status:   output.EndpointFailed,
+				Category: output.FailureCategoryTLSHandshake,
+			},
+		},
+		{
+			name:   "wildcard sample",
+			result: output.EndpointResult{URL: "https://oauth.apps.example.com:443", Host: "oauth.apps.example.com", Port: 443},
+			want:   output.EndpointResult{URL: "https://oauth.apps.example.com:443", Host: "oauth.apps.example.com", Port: 443, Wildcard: "*.apps.example.com"},
+		},
+		{
+			name:   "anyOf group member",
+			result: output.EndpointResult{URL: "https://mirror-a.example.com:443", Host: "mirror-a.example.com", Port: 443},
+			want:   output.EndpointResult{URL: "https://mirror-a.example.com:443", Host: "mirror-a.example.com", Port: 443, AnyOf: "mirrors"},
+		},
+		{
+			name:   "expected private address",
+			result: output.EndpointResult{Host: "sts.us-east-1.amazonaws.com", Port: 443, RemoteIP: "10.0.1.2", Status: output.EndpointPassed},
+			want:   output.EndpointResult{Host: "sts.us-east-1.amazonaws.com", Port: 443, RemoteIP: "10.0.1.2", Status: output.EndpointPassed},
+		},
+		{
+			name:   "unexpected public address",
+			result: output.EndpointResult{Host: "sts.us-east-1.amazonaws.com", Port: 443, RemoteIP: "54.240.250.235", Status: output.EndpointPassed},
+			want: output.EndpointResult{
+				Host:     "sts.us-east-1.amazonaws.com",
+				Port:     443,
+				RemoteIP: "54.240.250.235",
+				Status:   output.EndpointFailed,
+				Category: output.FailureCategoryNonPrivateAddress,
+				Message:  "reached at address '54.240.250.235', expected one in private",
+			},
+		},
+		{
+			name:   "address within an expected CIDR",
+			result: output.EndpointResult{Host: "s3.us-east-1.amazonaws.com", Port: 443, RemoteIP: "10.0.3.4", Status: output.EndpointPassed},
+			want:   output.EndpointResult{Host: "s3.us-east-1.amazonaws.com", Port: 443, RemoteIP: "10.0.3.4", Status: output.EndpointPassed},
+		},
+		{
+			name:   "private address outside the expected CIDR",
+			result: output.EndpointResult{Host: "s3.us-east-1.amazonaws.com", Port: 443, RemoteIP: "192.168.0.1", Status: output.EndpointPassed},
+			want: output.EndpointResult{
+				Host:     "s3.us-east-1.amazonaws.com",
+				Port:     443,
+				RemoteIP: "192.168.0.1",
+				Status:   output.EndpointFailed,
+				Category: output.FailureCategoryUnexpectedAddress,
+				Message:  "reached at address '192.168.0.1', expected one in public, 10.0.0.0/16",
+			},
+		},
+		{
+			name:   "unlisted port",
+			result: output.EndpointResult{Host: "console.redhat.com", Port: 8443},
+			want:   output.EndpointResult{Host: "console.redhat.com", Port: 8443},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &output.Output{}
+			out.AddEndpointResult(tt.result)
+			AnnotateEndpoints(out, egressList)
+			if got := out.EndpointResults()[0]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package gcpverifier
 import (
 	"encoding/base64"
 	"fmt"
+	"maps"
 	"math/rand"
 	"strconv"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
 	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
 	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
+	handledErrors "github.com/openshift/osd-network-verifier/pkg/errors"
 	"github.com/openshift/osd-network-verifier/pkg/output"
 	"github.com/openshift/osd-network-verifier/pkg/probes/curl"
 	"github.com/openshift/osd-network-verifier/pkg/verifier"
@@ -24,8 +26,12 @@ const (
 // - prepare for ComputeService instance creation
 // - create instance and wait till it gets ready, wait for startup script execution
 // - find unreachable endpoints & parse output, then terminate instance
-// - return `g.output` which stores the execution results
+// - return a new output which stores the execution results
 func (g *GcpVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.Output {
+	g = g.forRun()
+	start := time.Now()
+	defer func() { g.out.SetRunDuration(time.Since(start)) }()
+
 	// Validate cloud platform type and default to PlatformGCP if not specified
 	if !vei.PlatformType.IsValid() {
 		vei.PlatformType = cloud.GCPClassic
@@ -52,41 +58,73 @@ func (g *GcpVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 		var err error
 		vei.InstanceType, err = vei.CPUArchitecture.DefaultInstanceType(cloud.GCPClassic)
 		if err != nil {
-			return g.Output.AddError(err)
+			return g.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
 		}
 		g.Logger.Debug(vei.Ctx, fmt.Sprintf("defaulted to instance type %s", vei.InstanceType))
 	}
 
 	// Validate machine type
 	if err := g.validateMachineType(vei.GCP.ProjectID, vei.GCP.Zone, vei.InstanceType); err != nil {
-		return g.Output.AddError(fmt.Errorf("instance type %s is invalid: %s", vei.InstanceType, err))
+		return g.out.AddError(handledErrors.NewGenericError(fmt.Errorf("instance type %s is invalid: %s", vei.InstanceType, err)).WithCode(handledErrors.CodeInvalidConfiguration))
+	}
+
+	// Record the run's parameters so that they're available to consumers of the output
+	metadata := output.RunMetadata{
+		Platform:     vei.PlatformType.String(),
+		Region:       vei.GCP.Region,
+		SubnetID:     vei.SubnetID,
+		Probe:        fmt.Sprintf("%T", vei.Probe),
+		InstanceType: vei.InstanceType,
 	}
+	g.out.SetMetadata(metadata)
 
 	// Fetch the egress URL list from github, falling back to local lists in the event of a failure.
 	egressListYaml := vei.EgressListYaml
-	var egressListStr, tlsDisabledEgressListStr string
+	egressListVariables := map[string]string{
+		egress_lists.VariableGCPRegion:    vei.GCP.Region,
+		egress_lists.VariableGCPProjectID: vei.GCP.ProjectID,
+	}
+	maps.Copy(egressListVariables, vei.EgressListVariables)
+	var egressList *egress_lists.EgressList
+	metadata.EgressListSource = "custom"
 	if egressListYaml == "" {
-		githubEgressList, githubListErr := egress_lists.GetGithubEgressList(vei.PlatformType)
-		if githubListErr == nil {
-			egressListYaml, githubListErr = githubEgressList.GetContent()
-			if githubListErr == nil {
-				g.Logger.Debug(vei.Ctx, "Using egress URL list from %s at SHA %s", githubEgressList.GetURL(), githubEgressList.GetSHA())
-				egressListStr, tlsDisabledEgressListStr, githubListErr = egress_lists.EgressListToString(egressListYaml, map[string]string{})
-			}
+		fetched, err := egress_lists.FetchEgressList(vei.Ctx, vei.PlatformType, vei.EgressListFetchOptions)
+		if err != nil {
+			return g.out.AddError(handledErrors.NewGenericError(err))
+		}
+		egressList, err = fetched.Parse(vei.PlatformType, egressListVariables)
+		if err != nil {
+			return g.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
+		}
+		verifier.SetEgressListMetadata(&metadata, fetched)
+		if fetched.FallbackReason != "" {
+			// Recorded in the run's metadata, and the fallback list is still tested, so like on AWS
+			// this doesn't fail the run (which it would on every run without trusted keys)
+			g.Logger.Error(vei.Ctx, "%s", fetched.FallbackReason)
+		} else {
+			g.Logger.Debug(vei.Ctx, "Using %s", fetched.Description())
 		}
-		if githubListErr != nil {
-			var err error
-			g.Output.AddError(fmt.Errorf("failed to get egress list from GitHub, falling back to local list: %v", githubListErr))
-			egressListYaml, err = egress_lists.GetLocalEgressList(vei.PlatformType)
-			if err != nil {
-				return g.Output.AddError(err)
-			}
-			egressListStr, tlsDisabledEgressListStr, err = egress_lists.EgressListToString(egressListYaml, map[string]string{})
-			if err != nil {
-				return g.Output.AddError(err)
-			}
+		if fetched.Unverified {
+			g.Logger.Warn(vei.Ctx, "The signature of the %s wasn't verified", fetched.Description())
+		}
+	} else {
+		var err error
+		egressList, err = egress_lists.ParseEgressList(egressListYaml, egressListVariables)
+		if err != nil {
+			return g.out.AddError(handledErrors.NewGenericError(fmt.Errorf("invalid custom egress list: %w", err)).WithCode(handledErrors.CodeInvalidConfiguration))
+		}
+	}
+	if len(vei.EgressListOverlays) > 0 {
+		var err error
+		egressList, err = egressList.ApplyOverlays(vei.EgressListOverlays, egressListVariables)
+		if err != nil {
+			return g.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
 		}
 	}
+	// Skip endpoints whose region selectors exclude this region (e.g., endpoints only listed for
+	// some GCP regions). Endpoints selecting AWS partitions never apply on GCP
+	egressList = egressList.ForRegion(vei.GCP.Region)
+	egressListStr, tlsDisabledEgressListStr := egressList.ToString()
 
 	// Generate the userData file
 	// Expand replaces all ${var} (using empty string for unknown ones), adding the env variables used in startup-script.sh
@@ -110,7 +148,7 @@ func (g *GcpVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 
 	userData, err := vei.Probe.GetExpandedUserData(userDataVariables)
 	if err != nil {
-		return g.Output.AddError(err)
+		return g.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
 	}
 	g.Logger.Debug(vei.Ctx, "Generated userdata script:\n---\n%s\n---", userData)
 
@@ -119,11 +157,14 @@ func (g *GcpVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 	if vei.CloudImageID == "" {
 		vei.CloudImageID, err = vei.Probe.GetMachineImageID(vei.PlatformType, vei.CPUArchitecture, vei.GCP.Region)
 		if err != nil {
-			return g.Output.AddError(err)
+			return g.out.AddError(handledErrors.NewGenericError(err).WithCode(handledErrors.CodeInvalidConfiguration))
 		}
 	}
+	metadata.CloudImageID = vei.CloudImageID
+	g.out.SetMetadata(metadata)
 
 	// Create the ComputeService instance
+	launchStart := time.Now()
 	instance, err := g.createComputeServiceInstance(createComputeServiceInstanceInput{
 		projectID:        vei.GCP.ProjectID,
 		zone:             vei.GCP.Zone,
@@ -138,9 +179,9 @@ func (g *GcpVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 	})
 	// Try to terminate instance if instance creation fails
 	if err != nil {
-		g.Output.AddError(err)
+		g.out.AddError(err)
 		err = g.GcpClient.TerminateComputeServiceInstance(vei.GCP.ProjectID, vei.GCP.Zone, instance.Name)
-		return g.Output.AddError(err) // fatal
+		return g.out.AddError(err) // fatal
 	}
 
 	// Wait for the ComputeService instance to be running
@@ -149,25 +190,28 @@ func (g *GcpVerifier) ValidateEgress(vei verifier.ValidateEgressInput) *output.O
 		// try to terminate instance if instance is not running
 		err = g.GcpClient.TerminateComputeServiceInstance(vei.GCP.ProjectID, vei.GCP.Zone, instance.Name)
 		if err != nil {
-			g.Output.AddError(err)
+			g.out.AddError(err)
 		}
-		return g.Output.AddError(instanceReadyErr) // fatal
+		return g.out.AddError(instanceReadyErr) // fatal
 	}
+	g.out.SetInstanceLaunchDuration(time.Since(launchStart))
 
 	// Wait for console output and parse
 	g.Logger.Info(vei.Ctx, "Gathering and parsing console log output...")
 	err = g.findUnreachableEndpoints(vei.GCP.ProjectID, vei.GCP.Zone, instance.Name, vei.Probe)
 	if err != nil {
-		g.Output.AddError(err)
+		g.out.AddError(err)
 	}
+	// Mark optional endpoints and attach the egress list's documentation to each endpoint result
+	verifier.AnnotateEndpoints(g.out, egressList)
 
 	// Terminate the ComputeService instance after probe output is parsed and stored
 	err = g.GcpClient.TerminateComputeServiceInstance(vei.GCP.ProjectID, vei.GCP.Zone, instance.Name)
 	if err != nil {
-		g.Output.AddError(err)
+		g.out.AddError(err)
 	}
 
-	return &g.Output
+	return g.out
 }
 
 // TODO():
@@ -2,6 +2,7 @@ package gcpverifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -20,7 +21,13 @@ import (
 type GcpVerifier struct {
 	GcpClient gcp.Client
 	Logger    ocmlog.Logger
-	Output    output.Output
+	// Output is no longer populated, as each ValidateEgress run now records its results in an
+	// output of its own so that concurrent runs don't mix them.
+	//
+	// Deprecated: use the output returned by ValidateEgress instead
+	Output output.Output
+	// out collects the results of a single ValidateEgress run; see forRun()
+	out *output.Output
 }
 
 type createComputeServiceInstanceInput struct {
@@ -51,7 +58,13 @@ func NewGcpVerifier(creds *google.Credentials, debug bool) (*GcpVerifier, error)
 		return &GcpVerifier{}, err
 	}
 
-	return &GcpVerifier{*gcpClient, logger, output.Output{}}, nil
+	return &GcpVerifier{GcpClient: *gcpClient, Logger: logger}, nil
+}
+
+// forRun returns a copy of the verifier that records into a new output, so that runs sharing a
+// verifier don't mix their results
+func (g *GcpVerifier) forRun() *GcpVerifier {
+	return &GcpVerifier{GcpClient: g.GcpClient, Logger: g.Logger, out: &output.Output{}}
 }
 
 // Check that instance type is supported in zone
@@ -188,7 +201,7 @@ func (g *GcpVerifier) findUnreachableEndpoints(projectID, zone, instanceName str
 		if !startingTokenSeen {
 			if endingTokenSeen {
 				g.Logger.Debug(context.TODO(), "raw console logs:\n---\n%s\n---", output.Contents)
-				g.Output.AddException(handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: endingToken encountered before startingToken")))
+				g.out.AddException(handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: endingToken encountered before startingToken")).WithCode(handledErrors.CodeProbeCorrupt))
 				return false, nil
 			}
 			g.Logger.Debug(context.TODO(), "consoleOutput contains data, but probe has not yet printed startingToken, continuing to wait...")
@@ -204,16 +217,19 @@ func (g *GcpVerifier) findUnreachableEndpoints(projectID, zone, instanceName str
 		rawProbeOutput := strings.TrimSpace(helpers.CutBetween(consoleOutput, probe.GetStartingToken(), probe.GetEndingToken()))
 		if len(rawProbeOutput) < 1 {
 			g.Logger.Debug(context.TODO(), "raw console logs:\n---\n%s\n---", consoleOutput)
-			g.Output.AddException(handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: no data between startingToken and endingToken")))
+			g.out.AddException(handledErrors.NewGenericError(fmt.Errorf("probe output corrupted: no data between startingToken and endingToken")).WithCode(handledErrors.CodeProbeCorrupt))
 			return false, nil
 		}
 
 		// Send probe's output off to the Probe interface for parsing
 		g.Logger.Debug(context.TODO(), "probe output:\n---\n%s\n---", rawProbeOutput)
-		probe.ParseProbeOutput(false, rawProbeOutput, &g.Output)
+		probe.ParseProbeOutput(rawProbeOutput, g.out)
 
 		return true, nil
 	})
+	if errors.Is(err, helpers.ErrWaitTimeout) {
+		return handledErrors.NewGenericError(err).WithCode(handledErrors.CodeProbeTimeout)
+	}
 
 	return err
 }
@@ -257,7 +273,7 @@ func (c *GcpVerifier) waitForComputeServiceInstanceCompletion(projectID, zone, i
 			return false, fmt.Errorf("instance %s already exists with %v state. Please run again", instanceName, descError)
 
 		case "PERMISSION DENIED":
-			return false, fmt.Errorf("missing required permissions for account: %v", descError)
+			return false, handledErrors.NewGenericError(fmt.Errorf("missing required permissions for account: %v", descError)).WithCode(handledErrors.CodeIAMPermissionDenied)
 		}
 
 		if descError != nil {
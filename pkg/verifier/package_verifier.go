@@ -6,6 +6,7 @@ import (
 
 	"github.com/openshift/osd-network-verifier/pkg/data/cloud"
 	"github.com/openshift/osd-network-verifier/pkg/data/cpu"
+	"github.com/openshift/osd-network-verifier/pkg/data/egress_lists"
 	"github.com/openshift/osd-network-verifier/pkg/output"
 	"github.com/openshift/osd-network-verifier/pkg/probes"
 	"github.com/openshift/osd-network-verifier/pkg/proxy"
@@ -42,6 +43,19 @@ type ValidateEgressInput struct {
 	ImportKeyPair           string
 	ForceTempSecurityGroup  bool
 
+	// EgressListVariables supplies the values of ${VAR} placeholders in the egress list, in
+	// addition to (or overriding) those the verifier defines for the platform. See
+	// egress_lists.Variables. Placeholders referencing variables without a value fail the run
+	EgressListVariables map[string]string
+
+	// EgressListOverlays holds the YAML of egress list overlays, applied in order on top of the
+	// resolved egress list (EgressListYaml or the platform's list). See egress_lists.Overlay
+	EgressListOverlays []string
+
+	// EgressListFetchOptions controls how the platform's egress list is fetched from GitHub when
+	// EgressListYaml is empty, e.g., to pin it to a git ref or to only use the embedded lists
+	EgressListFetchOptions egress_lists.FetchOptions
+
 	// InstanceType sets the type or size of the instance (VM) launched into the target subnet. Only
 	// instance types using 64-bit X86 or ARM CPUs are supported. For AWS, only instance types using
 	// the "Nitro" hypervisor are supported, as other hypervisors don't allow the verifier to gather